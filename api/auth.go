@@ -0,0 +1,136 @@
+package api
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/geobuff/generate/utils"
+)
+
+const (
+	apiKeyHeader    = "X-API-Key"
+	signatureHeader = "X-Signature"
+	timestampHeader = "X-Timestamp"
+
+	defaultMaxClockSkew = 5 * time.Minute
+	// maxSignedBodyBytes caps how much of a signed request body is read to check
+	// its signature.
+	maxSignedBodyBytes = 1 << 20
+)
+
+// AuthConfig controls which credentials are accepted on protected routes. A
+// request is authorized if it satisfies any one of the enabled methods.
+type AuthConfig struct {
+	// APIKeys are static keys sent in the X-API-Key header or as a bearer token.
+	APIKeys []string
+	// HMACSecrets are shared secrets used to sign requests. The X-Signature header
+	// holds the hex encoded HMAC-SHA256 of the X-Timestamp header value, method,
+	// request URI and body separated by newlines.
+	HMACSecrets []string
+	// MaxClockSkew is how far the X-Timestamp of a signed request may be from now.
+	MaxClockSkew time.Duration
+}
+
+func (s *Server) authenticate(next http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if !s.auth.isAuthorized(request, time.Now()) {
+			writeErrorResponse(writer, http.StatusUnauthorized, errorResponse{
+				Code:    utils.ERROR_CODE_UNAUTHORIZED,
+				Message: "missing or invalid credentials",
+			})
+			return
+		}
+		next(writer, request)
+	}
+}
+
+func (c AuthConfig) isAuthorized(request *http.Request, now time.Time) bool {
+	if key := requestAPIKey(request); key != "" && matchesAny(c.APIKeys, key) {
+		return true
+	}
+
+	if request.Header.Get(signatureHeader) != "" {
+		return c.hasValidSignature(request, now)
+	}
+
+	return false
+}
+
+func requestAPIKey(request *http.Request) string {
+	if key := request.Header.Get(apiKeyHeader); key != "" {
+		return key
+	}
+
+	authorization := request.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, "Bearer ") {
+		return strings.TrimPrefix(authorization, "Bearer ")
+	}
+	return ""
+}
+
+func matchesAny(keys []string, key string) bool {
+	for _, val := range keys {
+		if val != "" && subtle.ConstantTimeCompare([]byte(val), []byte(key)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c AuthConfig) hasValidSignature(request *http.Request, now time.Time) bool {
+	timestamp := request.Header.Get(timestampHeader)
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	maxClockSkew := c.MaxClockSkew
+	if maxClockSkew == 0 {
+		maxClockSkew = defaultMaxClockSkew
+	}
+
+	skew := now.Sub(time.Unix(seconds, 0))
+	if skew > maxClockSkew || skew < -maxClockSkew {
+		return false
+	}
+
+	signature, err := hex.DecodeString(request.Header.Get(signatureHeader))
+	if err != nil {
+		return false
+	}
+
+	var body []byte
+	if request.Body != nil {
+		body, err = io.ReadAll(http.MaxBytesReader(nil, request.Body, maxSignedBodyBytes))
+		if err != nil {
+			return false
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	for _, secret := range c.HMACSecrets {
+		if secret == "" {
+			continue
+		}
+
+		if hmac.Equal(signature, signRequest(secret, timestamp, request.Method, request.URL.RequestURI(), body)) {
+			return true
+		}
+	}
+	return false
+}
+
+func signRequest(secret, timestamp, method, uri string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + method + "\n" + uri + "\n"))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
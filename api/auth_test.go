@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/geobuff/generate/utils"
+)
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Now()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	staleTimestamp := strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)
+	body := "body"
+
+	tt := []struct {
+		name    string
+		auth    AuthConfig
+		headers map[string]string
+		status  int
+	}{
+		{
+			name:    "no credentials",
+			auth:    AuthConfig{APIKeys: []string{"key"}},
+			headers: map[string]string{},
+			status:  http.StatusUnauthorized,
+		},
+		{
+			name:    "empty key configured",
+			auth:    AuthConfig{APIKeys: []string{""}},
+			headers: map[string]string{apiKeyHeader: ""},
+			status:  http.StatusUnauthorized,
+		},
+		{
+			name:    "invalid api key",
+			auth:    AuthConfig{APIKeys: []string{"key"}},
+			headers: map[string]string{apiKeyHeader: "wrong"},
+			status:  http.StatusUnauthorized,
+		},
+		{
+			name:    "valid api key",
+			auth:    AuthConfig{APIKeys: []string{"other", "key"}},
+			headers: map[string]string{apiKeyHeader: "key"},
+			status:  http.StatusOK,
+		},
+		{
+			name:    "valid bearer token",
+			auth:    AuthConfig{APIKeys: []string{"key"}},
+			headers: map[string]string{"Authorization": "Bearer key"},
+			status:  http.StatusOK,
+		},
+		{
+			name: "valid signature",
+			auth: AuthConfig{HMACSecrets: []string{"secret"}},
+			headers: map[string]string{
+				timestampHeader: timestamp,
+				signatureHeader: hex.EncodeToString(signRequest("secret", timestamp, "POST", "/api/trivia", []byte(body))),
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "signature with wrong secret",
+			auth: AuthConfig{HMACSecrets: []string{"secret"}},
+			headers: map[string]string{
+				timestampHeader: timestamp,
+				signatureHeader: hex.EncodeToString(signRequest("wrong", timestamp, "POST", "/api/trivia", []byte(body))),
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "stale signature",
+			auth: AuthConfig{HMACSecrets: []string{"secret"}},
+			headers: map[string]string{
+				timestampHeader: staleTimestamp,
+				signatureHeader: hex.EncodeToString(signRequest("secret", staleTimestamp, "POST", "/api/trivia", []byte(body))),
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:    "app engine cron header",
+			auth:    AuthConfig{APIKeys: []string{"key"}},
+			headers: map[string]string{"X-Appengine-Cron": "true"},
+			status:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewServer(":8080", 1, []string{}, []string{}, []string{}, tc.auth, new(utils.MockService))
+
+			request, err := http.NewRequest("POST", "/api/trivia", strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for key, value := range tc.headers {
+				request.Header.Set(key, value)
+			}
+
+			writer := httptest.NewRecorder()
+			server.authenticate(server.ping)(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestAuthenticateOversizedBody(t *testing.T) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	body := strings.Repeat("a", maxSignedBodyBytes+1)
+	server := NewServer(":8080", 1, []string{}, []string{}, []string{}, AuthConfig{HMACSecrets: []string{"secret"}}, new(utils.MockService))
+
+	request, err := http.NewRequest("POST", "/api/trivia", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set(timestampHeader, timestamp)
+	request.Header.Set(signatureHeader, hex.EncodeToString(signRequest("secret", timestamp, "POST", "/api/trivia", []byte(body))))
+
+	writer := httptest.NewRecorder()
+	server.authenticate(server.ping)(writer, request)
+	result := writer.Result()
+	defer result.Body.Close()
+
+	if result.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status %v; got %v", http.StatusUnauthorized, result.StatusCode)
+	}
+}
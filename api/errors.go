@@ -0,0 +1,56 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/geobuff/generate/utils"
+	"github.com/getsentry/sentry-go"
+)
+
+type errorResponse struct {
+	Code    utils.ErrorCode        `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// writeError responds with the JSON form of err and a status code matching its
+// kind. Unexpected and dependency failures are also reported to Sentry.
+func writeError(writer http.ResponseWriter, request *http.Request, err error) {
+	serviceErr := utils.ToServiceError(err)
+	status := errorStatus(serviceErr.Code)
+	if status >= http.StatusInternalServerError {
+		if hub := sentry.GetHubFromContext(request.Context()); hub != nil {
+			hub.CaptureException(err)
+		}
+	}
+
+	writeErrorResponse(writer, status, errorResponse{
+		Code:    serviceErr.Code,
+		Message: serviceErr.Message,
+		Details: serviceErr.Details,
+	})
+}
+
+func writeErrorResponse(writer http.ResponseWriter, status int, response errorResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(response)
+}
+
+func errorStatus(code utils.ErrorCode) int {
+	switch code {
+	case utils.ERROR_CODE_VALIDATION:
+		return http.StatusBadRequest
+	case utils.ERROR_CODE_UNAUTHORIZED:
+		return http.StatusUnauthorized
+	case utils.ERROR_CODE_NOT_FOUND:
+		return http.StatusNotFound
+	case utils.ERROR_CODE_CONFLICT:
+		return http.StatusConflict
+	case utils.ERROR_CODE_DEPENDENCY:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/utils"
+)
+
+func TestWriteError(t *testing.T) {
+	tt := []struct {
+		name   string
+		err    error
+		status int
+		code   utils.ErrorCode
+	}{
+		{
+			name:   "service validation error",
+			err:    utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, map[string]interface{}{"date": "x"}, "invalid date"),
+			status: http.StatusBadRequest,
+			code:   utils.ERROR_CODE_VALIDATION,
+		},
+		{
+			name:   "no rows",
+			err:    sql.ErrNoRows,
+			status: http.StatusNotFound,
+			code:   utils.ERROR_CODE_NOT_FOUND,
+		},
+		{
+			name:   "unique violation",
+			err:    &storage.DatabaseError{Kind: storage.ErrConflict, State: "23505", Message: "duplicate key", Err: errors.New("test")},
+			status: http.StatusConflict,
+			code:   utils.ERROR_CODE_CONFLICT,
+		},
+		{
+			name:   "connection failure",
+			err:    &storage.DatabaseError{Kind: storage.ErrUnavailable, State: "08006", Message: "connection failure", Err: errors.New("test")},
+			status: http.StatusServiceUnavailable,
+			code:   utils.ERROR_CODE_DEPENDENCY,
+		},
+		{
+			name:   "unexpected error",
+			err:    errors.New("test"),
+			status: http.StatusInternalServerError,
+			code:   utils.ERROR_CODE_INTERNAL,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			request, err := http.NewRequest("GET", "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writer := httptest.NewRecorder()
+			writeError(writer, request, tc.err)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+
+			var body errorResponse
+			if err := json.NewDecoder(result.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+
+			if body.Code != tc.code {
+				t.Errorf("expected code %v; got %v", tc.code, body.Code)
+			}
+
+			if body.Message == "" {
+				t.Error("expected a message")
+			}
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package api
 
 import (
-	"fmt"
+	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/geobuff/generate/types"
+	"github.com/geobuff/generate/utils"
 	"github.com/gorilla/mux"
 )
 
@@ -12,19 +16,181 @@ func (s *Server) ping(writer http.ResponseWriter, request *http.Request) {
 	writer.Write([]byte("PING SUCCESSFUL"))
 }
 
+func (s *Server) getTrivia(writer http.ResponseWriter, request *http.Request) {
+	date := mux.Vars(request)["date"]
+	trivia, err := s.service.GetTrivia(request.Context(), date)
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(trivia)
+}
+
+func (s *Server) getAllTrivia(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	filter := types.GetTriviaFilter{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+
+	var err error
+	if page := query.Get("page"); page != "" {
+		if filter.Page, err = strconv.Atoi(page); err != nil {
+			writeError(writer, request, utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, map[string]interface{}{"page": page}, "invalid page %s", page))
+			return
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil {
+			writeError(writer, request, utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, map[string]interface{}{"limit": limit}, "invalid limit %s", limit))
+			return
+		}
+	}
+
+	page, err := s.service.GetAllTrivia(request.Context(), filter)
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(page)
+}
+
 func (s *Server) createTrivia(writer http.ResponseWriter, request *http.Request) {
-	err := s.service.CreateTrivia()
+	recipe := request.URL.Query().Get("recipe")
+	err := s.service.CreateTrivia(request.Context(), recipe)
 	if err != nil {
-		http.Error(writer, fmt.Sprintf("%v\n", err), http.StatusInternalServerError)
+		writeError(writer, request, err)
 		return
 	}
 }
 
+func (s *Server) generateTriviaRange(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	force := false
+	if value := query.Get("force"); value != "" {
+		var err error
+		if force, err = strconv.ParseBool(value); err != nil {
+			writeError(writer, request, utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, map[string]interface{}{"force": value}, "invalid force %s", value))
+			return
+		}
+	}
+
+	results, err := s.service.GenerateTriviaRange(request.Context(), query.Get("from"), query.Get("to"), query.Get("recipe"), force)
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(results)
+}
+
+func (s *Server) previewTrivia(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	trivia, err := s.service.PreviewTrivia(request.Context(), query.Get("date"), query.Get("recipe"))
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(trivia)
+}
+
 func (s *Server) regenerateTrivia(writer http.ResponseWriter, request *http.Request) {
 	date := mux.Vars(request)["date"]
-	err := s.service.RegenerateTrivia(date)
+	query := request.URL.Query()
+	pinned, err := parseQuestionIDs(query["pin"])
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	err = s.service.RegenerateTrivia(request.Context(), date, query.Get("recipe"), pinned)
 	if err != nil {
-		http.Error(writer, fmt.Sprintf("%v\n", err), http.StatusInternalServerError)
+		writeError(writer, request, err)
 		return
 	}
 }
+
+func (s *Server) regenerateTriviaQuestion(writer http.ResponseWriter, request *http.Request) {
+	vars := mux.Vars(request)
+	questionID, err := strconv.Atoi(vars["questionId"])
+	if err != nil {
+		writeError(writer, request, utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, map[string]interface{}{"questionId": vars["questionId"]}, "invalid question id %s", vars["questionId"]))
+		return
+	}
+
+	err = s.service.RegenerateTriviaQuestion(request.Context(), vars["date"], questionID)
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+}
+
+func (s *Server) getAdjacency(writer http.ResponseWriter, request *http.Request) {
+	className := mux.Vars(request)["className"]
+	adjacency, err := s.service.GetAdjacency(request.Context(), className)
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(adjacency)
+}
+
+func (s *Server) getCacheStats(writer http.ResponseWriter, request *http.Request) {
+	stats, err := s.service.GetCacheStats(request.Context())
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(stats)
+}
+
+func (s *Server) invalidateCache(writer http.ResponseWriter, request *http.Request) {
+	err := s.service.InvalidateCache(request.Context(), request.URL.Query().Get("kind"))
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+}
+
+func (s *Server) getSchedulerStatus(writer http.ResponseWriter, request *http.Request) {
+	status, err := s.service.GetSchedulerStatus(request.Context())
+	if err != nil {
+		writeError(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(status)
+}
+
+// parseQuestionIDs accepts question ids as repeated query values, comma
+// separated values or a mix of both.
+func parseQuestionIDs(values []string) ([]int, error) {
+	var ids []int
+	for _, value := range values {
+		for _, id := range strings.Split(value, ",") {
+			if id == "" {
+				continue
+			}
+
+			parsed, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, map[string]interface{}{"questionId": id}, "invalid question id %s", id)
+			}
+			ids = append(ids, parsed)
+		}
+	}
+	return ids, nil
+}
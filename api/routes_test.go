@@ -1,17 +1,21 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
 	"github.com/geobuff/generate/utils"
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
 )
 
 func newTestServer(service utils.IService) *Server {
-	return NewServer(":8080", 1, []string{}, []string{}, []string{}, service)
+	return NewServer(":8080", 1, []string{}, []string{}, []string{}, AuthConfig{}, service)
 }
 
 func TestPing(t *testing.T) {
@@ -44,6 +48,11 @@ func TestCreateTrivia(t *testing.T) {
 			createTriviaResult: errors.New("test"),
 			status:             http.StatusInternalServerError,
 		},
+		{
+			name:               "generation already in progress",
+			createTriviaResult: utils.NewServiceError(utils.ERROR_CODE_CONFLICT, utils.ErrGenerationInProgress, nil, "trivia generation for date 2022-01-01 is already in progress"),
+			status:             http.StatusConflict,
+		},
 		{
 			name:               "happy path",
 			createTriviaResult: nil,
@@ -54,7 +63,7 @@ func TestCreateTrivia(t *testing.T) {
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			service := new(utils.MockService)
-			service.On("CreateTrivia").Return(tc.createTriviaResult)
+			service.On("CreateTrivia", mock.Anything, "").Return(tc.createTriviaResult)
 			server := newTestServer(service)
 
 			request, err := http.NewRequest("POST", "", nil)
@@ -78,15 +87,38 @@ func TestRegenerateTrivia(t *testing.T) {
 	tt := []struct {
 		name                   string
 		date                   string
+		query                  string
+		pinned                 []int
 		regenerateTriviaResult error
 		status                 int
 	}{
+		{
+			name:                   "invalid pinned question id",
+			date:                   "2022-01-01",
+			query:                  "?pin=1,two",
+			regenerateTriviaResult: nil,
+			status:                 http.StatusBadRequest,
+		},
+		{
+			name:                   "pinned questions",
+			date:                   "2022-01-01",
+			query:                  "?pin=1,2&pin=3",
+			pinned:                 []int{1, 2, 3},
+			regenerateTriviaResult: nil,
+			status:                 http.StatusOK,
+		},
 		{
 			name:                   "error on service.RegenerateTrivia",
 			date:                   "2022-01-01",
 			regenerateTriviaResult: errors.New("test"),
 			status:                 http.StatusInternalServerError,
 		},
+		{
+			name:                   "trivia already exists",
+			date:                   "2022-01-01",
+			regenerateTriviaResult: utils.NewServiceError(utils.ERROR_CODE_CONFLICT, utils.ErrTriviaExists, nil, "trivia for date 2022-01-01 already exists"),
+			status:                 http.StatusConflict,
+		},
 		{
 			name:                   "happy path",
 			date:                   "2022-01-01",
@@ -98,10 +130,10 @@ func TestRegenerateTrivia(t *testing.T) {
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			service := new(utils.MockService)
-			service.On("RegenerateTrivia", tc.date).Return(tc.regenerateTriviaResult)
+			service.On("RegenerateTrivia", mock.Anything, tc.date, "", tc.pinned).Return(tc.regenerateTriviaResult)
 			server := newTestServer(service)
 
-			request, err := http.NewRequest("PUT", "", nil)
+			request, err := http.NewRequest("PUT", tc.query, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -121,3 +153,478 @@ func TestRegenerateTrivia(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTrivia(t *testing.T) {
+	tt := []struct {
+		name            string
+		date            string
+		getTriviaResult *types.TriviaDto
+		getTriviaError  error
+		status          int
+	}{
+		{
+			name:            "trivia not found",
+			date:            "2022-01-01",
+			getTriviaResult: nil,
+			getTriviaError:  utils.NewServiceError(utils.ERROR_CODE_NOT_FOUND, utils.ErrTriviaNotFound, nil, "trivia for date 2022-01-01 does not exist"),
+			status:          http.StatusNotFound,
+		},
+		{
+			name:            "error on service.GetTrivia",
+			date:            "2022-01-01",
+			getTriviaResult: nil,
+			getTriviaError:  errors.New("test"),
+			status:          http.StatusInternalServerError,
+		},
+		{
+			name:            "happy path",
+			date:            "2022-01-01",
+			getTriviaResult: &types.TriviaDto{ID: 1, Name: "Saturday, January 1"},
+			getTriviaError:  nil,
+			status:          http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("GetTrivia", mock.Anything, tc.date).Return(tc.getTriviaResult, tc.getTriviaError)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("GET", "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			request = mux.SetURLVars(request, map[string]string{
+				"date": tc.date,
+			})
+
+			writer := httptest.NewRecorder()
+			server.getTrivia(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+
+			if tc.status == http.StatusOK {
+				var trivia types.TriviaDto
+				if err := json.NewDecoder(result.Body).Decode(&trivia); err != nil {
+					t.Fatal(err)
+				}
+
+				if trivia.ID != tc.getTriviaResult.ID {
+					t.Errorf("expected trivia %v; got %v", tc.getTriviaResult.ID, trivia.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAllTrivia(t *testing.T) {
+	tt := []struct {
+		name               string
+		query              string
+		filter             types.GetTriviaFilter
+		getAllTriviaResult *types.TriviaPageDto
+		getAllTriviaError  error
+		status             int
+	}{
+		{
+			name:   "invalid page",
+			query:  "?page=one",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:               "invalid date range",
+			query:              "?from=2022-02-01&to=2022-01-01",
+			filter:             types.GetTriviaFilter{From: "2022-02-01", To: "2022-01-01"},
+			getAllTriviaResult: nil,
+			getAllTriviaError:  utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, nil, "from 2022-02-01 is after to 2022-01-01"),
+			status:             http.StatusBadRequest,
+		},
+		{
+			name:               "happy path",
+			query:              "?from=2022-01-01&to=2022-02-01&page=1&limit=5",
+			filter:             types.GetTriviaFilter{From: "2022-01-01", To: "2022-02-01", Page: 1, Limit: 5},
+			getAllTriviaResult: &types.TriviaPageDto{Trivia: []types.TriviaDto{}, HasMore: false},
+			getAllTriviaError:  nil,
+			status:             http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("GetAllTrivia", mock.Anything, tc.filter).Return(tc.getAllTriviaResult, tc.getAllTriviaError)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("GET", tc.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writer := httptest.NewRecorder()
+			server.getAllTrivia(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestPreviewTrivia(t *testing.T) {
+	tt := []struct {
+		name                string
+		date                string
+		previewTriviaResult *types.TriviaDto
+		previewTriviaError  error
+		status              int
+	}{
+		{
+			name:                "invalid date",
+			date:                "2022-13-01",
+			previewTriviaResult: nil,
+			previewTriviaError:  utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, nil, "date 2022-13-01 must be in the format YYYY-MM-DD or one of today, tomorrow, yesterday or +Nd"),
+			status:              http.StatusBadRequest,
+		},
+		{
+			name:                "happy path",
+			date:                "2022-01-01",
+			previewTriviaResult: &types.TriviaDto{Name: "Saturday, January 1"},
+			previewTriviaError:  nil,
+			status:              http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("PreviewTrivia", mock.Anything, tc.date, "").Return(tc.previewTriviaResult, tc.previewTriviaError)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("POST", "?date="+tc.date, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writer := httptest.NewRecorder()
+			server.previewTrivia(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestGenerateTriviaRange(t *testing.T) {
+	tt := []struct {
+		name        string
+		query       string
+		force       bool
+		rangeResult []types.TriviaRangeResult
+		rangeErr    error
+		status      int
+	}{
+		{
+			name:        "invalid force",
+			query:       "?from=2022-01-01&to=2022-01-02&force=maybe",
+			rangeResult: nil,
+			rangeErr:    nil,
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "from after to",
+			query:       "?from=2022-01-02&to=2022-01-01",
+			rangeResult: nil,
+			rangeErr:    utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, nil, "from 2022-01-02 is after to 2022-01-01"),
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "happy path",
+			query:       "?from=2022-01-01&to=2022-01-02&force=true",
+			force:       true,
+			rangeResult: []types.TriviaRangeResult{{Date: "2022-01-01", Status: types.TRIVIA_RANGE_CREATED}, {Date: "2022-01-02", Status: types.TRIVIA_RANGE_REGENERATED}},
+			rangeErr:    nil,
+			status:      http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("GenerateTriviaRange", mock.Anything, mock.Anything, mock.Anything, "", tc.force).Return(tc.rangeResult, tc.rangeErr)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("POST", tc.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writer := httptest.NewRecorder()
+			server.generateTriviaRange(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			var results []types.TriviaRangeResult
+			if err := json.NewDecoder(result.Body).Decode(&results); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(results) != len(tc.rangeResult) {
+				t.Errorf("expected %d results; got %d", len(tc.rangeResult), len(results))
+			}
+		})
+	}
+}
+
+func TestRegenerateTriviaQuestion(t *testing.T) {
+	tt := []struct {
+		name                           string
+		questionID                     string
+		regenerateTriviaQuestionResult error
+		status                         int
+	}{
+		{
+			name:                           "invalid question id",
+			questionID:                     "one",
+			regenerateTriviaQuestionResult: nil,
+			status:                         http.StatusBadRequest,
+		},
+		{
+			name:                           "question not found",
+			questionID:                     "1",
+			regenerateTriviaQuestionResult: utils.NewServiceError(utils.ERROR_CODE_NOT_FOUND, utils.ErrQuestionNotFound, nil, "question 1 is not part of the trivia for date 2022-01-01"),
+			status:                         http.StatusNotFound,
+		},
+		{
+			name:                           "happy path",
+			questionID:                     "1",
+			regenerateTriviaQuestionResult: nil,
+			status:                         http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("RegenerateTriviaQuestion", mock.Anything, "2022-01-01", 1).Return(tc.regenerateTriviaQuestionResult)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("PUT", "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			request = mux.SetURLVars(request, map[string]string{
+				"date":       "2022-01-01",
+				"questionId": tc.questionID,
+			})
+
+			writer := httptest.NewRecorder()
+			server.regenerateTriviaQuestion(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestGetCacheStats(t *testing.T) {
+	tt := []struct {
+		name          string
+		cacheStats    map[string]storage.CacheStats
+		cacheStatsErr error
+		status        int
+	}{
+		{
+			name:          "cache disabled",
+			cacheStats:    nil,
+			cacheStatsErr: utils.NewServiceError(utils.ERROR_CODE_NOT_FOUND, utils.ErrCacheDisabled, nil, "reference data cache is not enabled"),
+			status:        http.StatusNotFound,
+		},
+		{
+			name:          "happy path",
+			cacheStats:    map[string]storage.CacheStats{storage.CACHE_KIND_MAPS: {Hits: 1}},
+			cacheStatsErr: nil,
+			status:        http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("GetCacheStats", mock.Anything).Return(tc.cacheStats, tc.cacheStatsErr)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("GET", "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writer := httptest.NewRecorder()
+			server.getCacheStats(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestInvalidateCache(t *testing.T) {
+	tt := []struct {
+		name               string
+		kind               string
+		invalidateCacheErr error
+		status             int
+	}{
+		{
+			name:               "unknown kind",
+			kind:               "flags",
+			invalidateCacheErr: utils.NewServiceError(utils.ERROR_CODE_VALIDATION, utils.ErrInvalidInput, nil, "unknown cache kind flags"),
+			status:             http.StatusBadRequest,
+		},
+		{
+			name:               "happy path",
+			kind:               storage.CACHE_KIND_MAPS,
+			invalidateCacheErr: nil,
+			status:             http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("InvalidateCache", mock.Anything, tc.kind).Return(tc.invalidateCacheErr)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("DELETE", "?kind="+tc.kind, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writer := httptest.NewRecorder()
+			server.invalidateCache(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestGetSchedulerStatus(t *testing.T) {
+	tt := []struct {
+		name               string
+		schedulerStatus    *types.SchedulerStatus
+		schedulerStatusErr error
+		status             int
+	}{
+		{
+			name:               "scheduler disabled",
+			schedulerStatus:    nil,
+			schedulerStatusErr: utils.NewServiceError(utils.ERROR_CODE_NOT_FOUND, utils.ErrSchedulerDisabled, nil, "trivia scheduler is not enabled"),
+			status:             http.StatusNotFound,
+		},
+		{
+			name:               "happy path",
+			schedulerStatus:    &types.SchedulerStatus{Running: true, LookaheadDays: 7},
+			schedulerStatusErr: nil,
+			status:             http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("GetSchedulerStatus", mock.Anything).Return(tc.schedulerStatus, tc.schedulerStatusErr)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("GET", "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writer := httptest.NewRecorder()
+			server.getSchedulerStatus(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestGetAdjacency(t *testing.T) {
+	tt := []struct {
+		name         string
+		className    string
+		adjacency    *types.AdjacencyDto
+		adjacencyErr error
+		status       int
+	}{
+		{
+			name:         "map not found",
+			className:    "Unknown",
+			adjacency:    nil,
+			adjacencyErr: utils.NewServiceError(utils.ERROR_CODE_NOT_FOUND, utils.ErrMapNotFound, nil, "map Unknown does not exist"),
+			status:       http.StatusNotFound,
+		},
+		{
+			name:         "happy path",
+			className:    "WorldCountries",
+			adjacency:    &types.AdjacencyDto{Map: "WorldCountries", Neighbours: map[string][]string{"France": {"Spain"}, "Spain": {"France"}}},
+			adjacencyErr: nil,
+			status:       http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := new(utils.MockService)
+			service.On("GetAdjacency", mock.Anything, tc.className).Return(tc.adjacency, tc.adjacencyErr)
+			server := newTestServer(service)
+
+			request, err := http.NewRequest("GET", "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			request = mux.SetURLVars(request, map[string]string{"className": tc.className})
+			writer := httptest.NewRecorder()
+			server.getAdjacency(writer, request)
+			result := writer.Result()
+			defer result.Body.Close()
+
+			if result.StatusCode != tc.status {
+				t.Errorf("expected status %v; got %v", tc.status, result.StatusCode)
+			}
+		})
+	}
+}
@@ -20,16 +20,18 @@ type Server struct {
 	allowedOrigins []string
 	allowedMethods []string
 	allowedHeaders []string
+	auth           AuthConfig
 	service        utils.IService
 }
 
-func NewServer(listenAddr string, rateLimiterMax float64, allowedOrigins []string, allowedMethods []string, allowedHeaders []string, service utils.IService) *Server {
+func NewServer(listenAddr string, rateLimiterMax float64, allowedOrigins []string, allowedMethods []string, allowedHeaders []string, auth AuthConfig, service utils.IService) *Server {
 	return &Server{
 		listenAddr,
 		rateLimiterMax,
 		allowedOrigins,
 		allowedMethods,
 		allowedHeaders,
+		auth,
 		service,
 	}
 }
@@ -48,8 +50,17 @@ func (s *Server) Start() error {
 	sentryHandler := sentryhttp.New(sentryhttp.Options{})
 	router := mux.NewRouter()
 	router.HandleFunc("/", s.ping)
-	router.HandleFunc("/api/trivia", sentryHandler.HandleFunc(s.createTrivia)).Methods("POST")
-	router.HandleFunc("/api/trivia/{date}", sentryHandler.HandleFunc(s.regenerateTrivia)).Methods("PUT")
+	router.HandleFunc("/api/trivia", sentryHandler.HandleFunc(s.authenticate(s.getAllTrivia))).Methods("GET")
+	router.HandleFunc("/api/trivia/{date}", sentryHandler.HandleFunc(s.authenticate(s.getTrivia))).Methods("GET")
+	router.HandleFunc("/api/trivia", sentryHandler.HandleFunc(s.authenticate(s.createTrivia))).Methods("POST")
+	router.HandleFunc("/api/trivia/range", sentryHandler.HandleFunc(s.authenticate(s.generateTriviaRange))).Methods("POST")
+	router.HandleFunc("/api/trivia/preview", sentryHandler.HandleFunc(s.authenticate(s.previewTrivia))).Methods("POST")
+	router.HandleFunc("/api/trivia/{date}", sentryHandler.HandleFunc(s.authenticate(s.regenerateTrivia))).Methods("PUT")
+	router.HandleFunc("/api/trivia/{date}/questions/{questionId}", sentryHandler.HandleFunc(s.authenticate(s.regenerateTriviaQuestion))).Methods("PUT")
+	router.HandleFunc("/api/maps/{className}/adjacency", sentryHandler.HandleFunc(s.authenticate(s.getAdjacency))).Methods("GET")
+	router.HandleFunc("/api/cache", sentryHandler.HandleFunc(s.authenticate(s.getCacheStats))).Methods("GET")
+	router.HandleFunc("/api/cache", sentryHandler.HandleFunc(s.authenticate(s.invalidateCache))).Methods("DELETE")
+	router.HandleFunc("/api/scheduler", sentryHandler.HandleFunc(s.authenticate(s.getSchedulerStatus))).Methods("GET")
 
 	limiter := tollbooth.LimitHandler(tollbooth.NewLimiter(s.rateLimiterMax, nil), s.handler(router))
 	return http.ListenAndServe(s.listenAddr, limiter)
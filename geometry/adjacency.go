@@ -0,0 +1,119 @@
+package geometry
+
+import (
+	"math"
+	"sort"
+)
+
+// Graph lists the neighbours of every named shape in name order. Shapes without
+// neighbours have an empty list.
+type Graph map[string][]string
+
+// Adjacency finds which shapes border each other. Two shapes border when their
+// outlines come within roughly tolerance of each other, so tolerance should
+// cover the gaps left when the map outlines were simplified.
+func Adjacency(shapes map[string]Shape, tolerance float64) Graph {
+	// Every outline is sampled at least once per tolerance and the samples are
+	// bucketed into a grid of tolerance sized cells. Shapes with samples in the same
+	// or neighbouring cells are close enough to border.
+	cells := make(map[[2]int]map[string]bool)
+	for name, shape := range shapes {
+		for _, point := range sample(shape, tolerance) {
+			cell := [2]int{int(math.Floor(point.X / tolerance)), int(math.Floor(point.Y / tolerance))}
+			if cells[cell] == nil {
+				cells[cell] = make(map[string]bool)
+			}
+			cells[cell][name] = true
+		}
+	}
+
+	neighbours := make(map[string]map[string]bool)
+	for name := range shapes {
+		neighbours[name] = make(map[string]bool)
+	}
+
+	for cell, names := range cells {
+		for dx := -1; dx <= 1; dx++ {
+			for dy := -1; dy <= 1; dy++ {
+				for other := range cells[[2]int{cell[0] + dx, cell[1] + dy}] {
+					for name := range names {
+						if name != other {
+							neighbours[name][other] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	graph := make(Graph)
+	for name, set := range neighbours {
+		list := []string{}
+		for other := range set {
+			list = append(list, other)
+		}
+		sort.Strings(list)
+		graph[name] = list
+	}
+	return graph
+}
+
+// sample returns points along every edge of the shape no more than step apart.
+func sample(shape Shape, step float64) []Point {
+	var points []Point
+	for _, ring := range shape {
+		for i, from := range ring {
+			to := ring[(i+1)%len(ring)]
+			length := math.Hypot(to.X-from.X, to.Y-from.Y)
+			count := int(math.Ceil(length / step))
+			if count == 0 {
+				count = 1
+			}
+
+			for j := 0; j < count; j++ {
+				t := float64(j) / float64(count)
+				points = append(points, Point{from.X + (to.X-from.X)*t, from.Y + (to.Y-from.Y)*t})
+			}
+		}
+	}
+	return points
+}
+
+func (g Graph) Adjacent(a, b string) bool {
+	for _, val := range g[a] {
+		if val == b {
+			return true
+		}
+	}
+	return false
+}
+
+// Set adds or removes the border between two shapes.
+func (g Graph) Set(a, b string, adjacent bool) {
+	g[a] = setNeighbour(g[a], b, adjacent)
+	g[b] = setNeighbour(g[b], a, adjacent)
+}
+
+func setNeighbour(neighbours []string, name string, adjacent bool) []string {
+	result := []string{}
+	for _, val := range neighbours {
+		if val != name {
+			result = append(result, val)
+		}
+	}
+
+	if adjacent {
+		result = append(result, name)
+		sort.Strings(result)
+	}
+	return result
+}
+
+// Copy returns a graph that can be changed without affecting g.
+func (g Graph) Copy() Graph {
+	result := make(Graph)
+	for name, neighbours := range g {
+		result[name] = append([]string{}, neighbours...)
+	}
+	return result
+}
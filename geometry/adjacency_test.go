@@ -0,0 +1,52 @@
+package geometry
+
+import (
+	"reflect"
+	"testing"
+)
+
+func square(x, y, size float64) Shape {
+	return Shape{{{x, y}, {x + size, y}, {x + size, y + size}, {x, y + size}}}
+}
+
+func TestAdjacency(t *testing.T) {
+	// A and B share an edge, B and C are separated by a gap smaller than the
+	// tolerance, D is an island and E only touches A through a thin sliver.
+	shapes := map[string]Shape{
+		"A": square(0, 0, 10),
+		"B": square(10, 0, 10),
+		"C": square(20.4, 0, 10),
+		"D": square(50, 50, 5),
+		"E": Shape{{{0, 10.2}, {3, 10.2}, {3, 20}, {0, 20}}},
+	}
+
+	graph := Adjacency(shapes, 0.5)
+	expected := Graph{
+		"A": {"B", "E"},
+		"B": {"A", "C"},
+		"C": {"B"},
+		"D": {},
+		"E": {"A"},
+	}
+
+	if !reflect.DeepEqual(graph, expected) {
+		t.Errorf("expected %v; got %v", expected, graph)
+	}
+}
+
+func TestGraphSet(t *testing.T) {
+	graph := Graph{"A": {"B"}, "B": {"A"}, "C": {}}
+	copied := graph.Copy()
+
+	copied.Set("A", "B", false)
+	copied.Set("C", "A", true)
+
+	expected := Graph{"A": {"C"}, "B": {}, "C": {"A"}}
+	if !reflect.DeepEqual(copied, expected) {
+		t.Errorf("expected %v; got %v", expected, copied)
+	}
+
+	if !graph.Adjacent("A", "B") || graph.Adjacent("A", "C") {
+		t.Errorf("expected the original graph to be unchanged; got %v", graph)
+	}
+}
@@ -0,0 +1,129 @@
+package geometry
+
+import "math"
+
+type Rect struct {
+	Min Point
+	Max Point
+}
+
+func (r Rect) Width() float64 {
+	return r.Max.X - r.Min.X
+}
+
+func (r Rect) Height() float64 {
+	return r.Max.Y - r.Min.Y
+}
+
+// Area returns the area the shape covers in map units, as drawn in the map's
+// projection. Rings inside an odd number of the shape's other rings are holes,
+// such as a country surrounding another, and are taken away.
+func (s Shape) Area() float64 {
+	area := 0.0
+	for i, ring := range s {
+		area = area + s.weight(i, ring)
+	}
+	return area
+}
+
+// Bounds returns the smallest rectangle holding every point of the shape.
+func (s Shape) Bounds() Rect {
+	bounds := Rect{Point{math.Inf(1), math.Inf(1)}, Point{math.Inf(-1), math.Inf(-1)}}
+	for _, ring := range s {
+		for _, point := range ring {
+			bounds.Min.X = math.Min(bounds.Min.X, point.X)
+			bounds.Min.Y = math.Min(bounds.Min.Y, point.Y)
+			bounds.Max.X = math.Max(bounds.Max.X, point.X)
+			bounds.Max.Y = math.Max(bounds.Max.Y, point.Y)
+		}
+	}
+
+	if len(s) == 0 {
+		return Rect{}
+	}
+	return bounds
+}
+
+// Centroid returns the centre of mass of the shape. Shapes without area fall back
+// to the centre of their bounds.
+func (s Shape) Centroid() Point {
+	var x, y, total float64
+	for i, ring := range s {
+		weight := s.weight(i, ring)
+		centroid, ok := ring.centroid()
+		if !ok {
+			continue
+		}
+
+		x = x + centroid.X*weight
+		y = y + centroid.Y*weight
+		total = total + weight
+	}
+
+	if total == 0 {
+		bounds := s.Bounds()
+		return Point{(bounds.Min.X + bounds.Max.X) / 2, (bounds.Min.Y + bounds.Max.Y) / 2}
+	}
+	return Point{x / total, y / total}
+}
+
+// weight returns the unsigned area of the ring at index i, negated if the ring is
+// a hole.
+func (s Shape) weight(i int, ring Ring) float64 {
+	area := math.Abs(ring.signedArea())
+	if len(ring) == 0 {
+		return 0
+	}
+
+	depth := 0
+	for j, other := range s {
+		if j != i && other.contains(ring[0]) {
+			depth++
+		}
+	}
+
+	if depth%2 == 1 {
+		return -area
+	}
+	return area
+}
+
+func (r Ring) signedArea() float64 {
+	area := 0.0
+	for i, from := range r {
+		to := r[(i+1)%len(r)]
+		area = area + from.X*to.Y - to.X*from.Y
+	}
+	return area / 2
+}
+
+func (r Ring) centroid() (Point, bool) {
+	area := r.signedArea()
+	if area == 0 {
+		return Point{}, false
+	}
+
+	var x, y float64
+	for i, from := range r {
+		to := r[(i+1)%len(r)]
+		cross := from.X*to.Y - to.X*from.Y
+		x = x + (from.X+to.X)*cross
+		y = y + (from.Y+to.Y)*cross
+	}
+	return Point{x / (6 * area), y / (6 * area)}, true
+}
+
+// contains reports whether the point is inside the ring using the even-odd rule.
+func (r Ring) contains(point Point) bool {
+	inside := false
+	for i, from := range r {
+		to := r[(i+1)%len(r)]
+		if (from.Y > point.Y) != (to.Y > point.Y) {
+			x := from.X + (point.Y-from.Y)*(to.X-from.X)/(to.Y-from.Y)
+			if point.X < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
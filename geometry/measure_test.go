@@ -0,0 +1,72 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+)
+
+func TestShapeMeasurements(t *testing.T) {
+	tt := []struct {
+		name     string
+		shape    Shape
+		area     float64
+		bounds   Rect
+		centroid Point
+	}{
+		{
+			name:     "square",
+			shape:    square(0, 0, 10),
+			area:     100,
+			bounds:   Rect{Point{0, 0}, Point{10, 10}},
+			centroid: Point{5, 5},
+		},
+		{
+			name:     "reversed winding",
+			shape:    Shape{{{0, 0}, {0, 10}, {10, 10}, {10, 0}}},
+			area:     100,
+			bounds:   Rect{Point{0, 0}, Point{10, 10}},
+			centroid: Point{5, 5},
+		},
+		{
+			name:     "mainland and island",
+			shape:    append(square(0, 0, 10), square(20, 0, 10)...),
+			area:     200,
+			bounds:   Rect{Point{0, 0}, Point{30, 10}},
+			centroid: Point{15, 5},
+		},
+		{
+			name:     "hole",
+			shape:    append(square(0, 0, 10), square(2, 2, 5)[0]),
+			area:     75,
+			bounds:   Rect{Point{0, 0}, Point{10, 10}},
+			centroid: Point{387.5 / 75, 387.5 / 75},
+		},
+		{
+			name:     "line",
+			shape:    Shape{{{0, 0}, {10, 0}}},
+			area:     0,
+			bounds:   Rect{Point{0, 0}, Point{10, 0}},
+			centroid: Point{5, 0},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if area := tc.shape.Area(); !near(area, tc.area) {
+				t.Errorf("expected area %v; got %v", tc.area, area)
+			}
+
+			if bounds := tc.shape.Bounds(); bounds != tc.bounds {
+				t.Errorf("expected bounds %v; got %v", tc.bounds, bounds)
+			}
+
+			if centroid := tc.shape.Centroid(); !near(centroid.X, tc.centroid.X) || !near(centroid.Y, tc.centroid.Y) {
+				t.Errorf("expected centroid %v; got %v", tc.centroid, centroid)
+			}
+		})
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
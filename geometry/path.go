@@ -0,0 +1,326 @@
+// Package geometry reads the outlines of SVG map elements and works out how large
+// they are, where they sit and which of them touch.
+package geometry
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Ring is a closed outline. The last point joins back to the first.
+type Ring []Point
+
+// Shape is every outline of a map element, such as a country and its islands.
+type Shape []Ring
+
+// curveSamples is how many points a bezier curve is flattened into.
+const curveSamples = 4
+
+// ParsePath reads the outline of an SVG path from its d attribute. Bezier curves
+// are flattened into a few straight segments and elliptical arcs are replaced by
+// a straight line to their end point, which is close enough to compare borders.
+func ParsePath(d string) (Shape, error) {
+	tokens, err := tokenizePath(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var shape Shape
+	var ring Ring
+	var current, start, control Point
+	var command, previous byte
+	i := 0
+
+	numbers := func(count int) ([]float64, error) {
+		if i+count > len(tokens) {
+			return nil, fmt.Errorf("path command %c expects %d numbers", command, count)
+		}
+
+		result := make([]float64, count)
+		for j := 0; j < count; j++ {
+			if tokens[i+j].command != 0 {
+				return nil, fmt.Errorf("path command %c expects %d numbers", command, count)
+			}
+			result[j] = tokens[i+j].value
+		}
+		i = i + count
+		return result, nil
+	}
+
+	closeRing := func() {
+		if len(ring) > 1 {
+			shape = append(shape, ring)
+		}
+		ring = nil
+	}
+
+	for i < len(tokens) {
+		if tokens[i].command != 0 {
+			command = tokens[i].command
+			i++
+		} else if command == 0 {
+			return nil, fmt.Errorf("path must start with a command")
+		}
+
+		relative := command >= 'a' && command <= 'z'
+		offset := func(x, y float64) Point {
+			if relative {
+				return Point{current.X + x, current.Y + y}
+			}
+			return Point{x, y}
+		}
+
+		switch upper(command) {
+		case 'M':
+			values, err := numbers(2)
+			if err != nil {
+				return nil, err
+			}
+
+			closeRing()
+			current = offset(values[0], values[1])
+			start = current
+			ring = Ring{current}
+			// Coordinates following a move are implicit line commands.
+			if relative {
+				command = 'l'
+			} else {
+				command = 'L'
+			}
+		case 'L':
+			values, err := numbers(2)
+			if err != nil {
+				return nil, err
+			}
+			current = offset(values[0], values[1])
+			ring = append(ring, current)
+		case 'H':
+			values, err := numbers(1)
+			if err != nil {
+				return nil, err
+			}
+			if relative {
+				current.X = current.X + values[0]
+			} else {
+				current.X = values[0]
+			}
+			ring = append(ring, current)
+		case 'V':
+			values, err := numbers(1)
+			if err != nil {
+				return nil, err
+			}
+			if relative {
+				current.Y = current.Y + values[0]
+			} else {
+				current.Y = values[0]
+			}
+			ring = append(ring, current)
+		case 'C', 'S':
+			count := 6
+			if upper(command) == 'S' {
+				count = 4
+			}
+
+			values, err := numbers(count)
+			if err != nil {
+				return nil, err
+			}
+
+			first := reflectControl(current, control, previous, 'C', 'S')
+			if count == 6 {
+				first = offset(values[0], values[1])
+				values = values[2:]
+			}
+			second := offset(values[0], values[1])
+			end := offset(values[2], values[3])
+			ring = append(ring, cubic(current, first, second, end)...)
+			current, control = end, second
+		case 'Q', 'T':
+			count := 4
+			if upper(command) == 'T' {
+				count = 2
+			}
+
+			values, err := numbers(count)
+			if err != nil {
+				return nil, err
+			}
+
+			first := reflectControl(current, control, previous, 'Q', 'T')
+			if count == 4 {
+				first = offset(values[0], values[1])
+				values = values[2:]
+			}
+			end := offset(values[0], values[1])
+			ring = append(ring, quadratic(current, first, end)...)
+			current, control = end, first
+		case 'A':
+			values, err := numbers(7)
+			if err != nil {
+				return nil, err
+			}
+			current = offset(values[5], values[6])
+			ring = append(ring, current)
+		case 'Z':
+			// Z takes no numbers, so anything but another command after it is
+			// malformed and would otherwise never be consumed.
+			if i < len(tokens) && tokens[i].command == 0 {
+				return nil, fmt.Errorf("path command %c expects no numbers", command)
+			}
+			// A drawing command straight after Z starts a new subpath at the
+			// start of the one just closed.
+			closeRing()
+			current = start
+			ring = Ring{start}
+		default:
+			return nil, fmt.Errorf("unsupported path command %c", command)
+		}
+
+		previous = upper(command)
+	}
+
+	closeRing()
+	return shape, nil
+}
+
+// ParsePoints reads the outline of an SVG polygon from its points attribute.
+func ParsePoints(points string) (Shape, error) {
+	tokens, err := tokenizePath(points)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens)%2 != 0 {
+		return nil, fmt.Errorf("points must be pairs of numbers")
+	}
+
+	var ring Ring
+	for i := 0; i < len(tokens); i = i + 2 {
+		if tokens[i].command != 0 || tokens[i+1].command != 0 {
+			return nil, fmt.Errorf("points cannot contain commands")
+		}
+		ring = append(ring, Point{tokens[i].value, tokens[i+1].value})
+	}
+
+	if len(ring) < 2 {
+		return nil, nil
+	}
+	return Shape{ring}, nil
+}
+
+// reflectControl returns the first control point of a smooth curve: the reflection of
+// the previous curve's last control point, or the current point if the previous
+// command was not a curve of the same kind.
+func reflectControl(current, control Point, previous, curve, smooth byte) Point {
+	if previous != curve && previous != smooth {
+		return current
+	}
+	return Point{2*current.X - control.X, 2*current.Y - control.Y}
+}
+
+func cubic(p0, p1, p2, p3 Point) []Point {
+	var points []Point
+	for i := 1; i <= curveSamples; i++ {
+		t := float64(i) / curveSamples
+		u := 1 - t
+		points = append(points, Point{
+			u*u*u*p0.X + 3*u*u*t*p1.X + 3*u*t*t*p2.X + t*t*t*p3.X,
+			u*u*u*p0.Y + 3*u*u*t*p1.Y + 3*u*t*t*p2.Y + t*t*t*p3.Y,
+		})
+	}
+	return points
+}
+
+func quadratic(p0, p1, p2 Point) []Point {
+	var points []Point
+	for i := 1; i <= curveSamples; i++ {
+		t := float64(i) / curveSamples
+		u := 1 - t
+		points = append(points, Point{
+			u*u*p0.X + 2*u*t*p1.X + t*t*p2.X,
+			u*u*p0.Y + 2*u*t*p1.Y + t*t*p2.Y,
+		})
+	}
+	return points
+}
+
+func upper(command byte) byte {
+	if command >= 'a' && command <= 'z' {
+		return command - 'a' + 'A'
+	}
+	return command
+}
+
+type pathToken struct {
+	command byte
+	value   float64
+}
+
+// tokenizePath splits path data into commands and numbers. Numbers may run into
+// each other without a separator, as in "1-2" or "1.5.5".
+func tokenizePath(d string) ([]pathToken, error) {
+	var tokens []pathToken
+	i := 0
+	for i < len(d) {
+		c := d[i]
+		switch {
+		case c == ' ' || c == ',' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.IndexByte("MmLlHhVvCcSsQqTtAaZz", c) >= 0:
+			tokens = append(tokens, pathToken{command: c})
+			i++
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			end := scanNumber(d, i)
+			value, err := strconv.ParseFloat(d[i:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q in path", d[i:end])
+			}
+			tokens = append(tokens, pathToken{value: value})
+			i = end
+		default:
+			return nil, fmt.Errorf("unexpected character %q in path", c)
+		}
+	}
+	return tokens, nil
+}
+
+func scanNumber(d string, i int) int {
+	if d[i] == '-' || d[i] == '+' {
+		i++
+	}
+
+	dot := false
+	for i < len(d) {
+		c := d[i]
+		switch {
+		case c >= '0' && c <= '9':
+			i++
+		case c == '.' && !dot:
+			dot = true
+			i++
+		case (c == 'e' || c == 'E') && i+1 < len(d):
+			next := i + 1
+			if d[next] == '-' || d[next] == '+' {
+				next++
+			}
+			if next >= len(d) || d[next] < '0' || d[next] > '9' {
+				return i
+			}
+			i = next
+			for i < len(d) && d[i] >= '0' && d[i] <= '9' {
+				i++
+			}
+			return i
+		default:
+			return i
+		}
+	}
+	return i
+}
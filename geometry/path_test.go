@@ -0,0 +1,150 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	tt := []struct {
+		name     string
+		d        string
+		expected Shape
+		err      string
+	}{
+		{
+			name:     "absolute lines",
+			d:        "M0,0 L10,0 L10,10 Z",
+			expected: Shape{{{0, 0}, {10, 0}, {10, 10}}},
+		},
+		{
+			name:     "relative lines without separators",
+			d:        "m5 5l10-0 0 10-10 0z",
+			expected: Shape{{{5, 5}, {15, 5}, {15, 15}, {5, 15}}},
+		},
+		{
+			name:     "horizontal and vertical lines",
+			d:        "M1 1H4V4h-3v-3Z",
+			expected: Shape{{{1, 1}, {4, 1}, {4, 4}, {1, 4}, {1, 1}}},
+		},
+		{
+			name:     "numbers running together",
+			d:        "M.5.5L1.5.5 1.5 1.5",
+			expected: Shape{{{0.5, 0.5}, {1.5, 0.5}, {1.5, 1.5}}},
+		},
+		{
+			name:     "multiple rings",
+			d:        "M0 0L1 0L1 1ZM5 5l1 0l0 1z",
+			expected: Shape{{{0, 0}, {1, 0}, {1, 1}}, {{5, 5}, {6, 5}, {6, 6}}},
+		},
+		{
+			name:     "relative move after close",
+			d:        "M2 2L3 2Zm1 1l1 0",
+			expected: Shape{{{2, 2}, {3, 2}}, {{3, 3}, {4, 3}}},
+		},
+		{
+			name:     "line after close",
+			d:        "M0 0h10v10Zl5 5",
+			expected: Shape{{{0, 0}, {10, 0}, {10, 10}}, {{0, 0}, {5, 5}}},
+		},
+		{
+			name:     "arc ends at its end point",
+			d:        "M0 0A5 5 0 0 1 10 0",
+			expected: Shape{{{0, 0}, {10, 0}}},
+		},
+		{
+			name:     "cubic curve",
+			d:        "M0 0C0 10 10 10 10 0",
+			expected: Shape{{{0, 0}, {1.5625, 5.625}, {5, 7.5}, {8.4375, 5.625}, {10, 0}}},
+		},
+		{
+			name:     "exponent",
+			d:        "M1e1 0L2E+1 0",
+			expected: Shape{{{10, 0}, {20, 0}}},
+		},
+		{
+			name: "missing command",
+			d:    "0 0 L1 1",
+			err:  "path must start with a command",
+		},
+		{
+			name: "missing numbers",
+			d:    "M0 0 L1",
+			err:  "path command L expects 2 numbers",
+		},
+		{
+			name:     "bare close",
+			d:        "Z",
+			expected: nil,
+		},
+		{
+			name: "numbers after close",
+			d:    "M0 0 L1 1 Z 5 5",
+			err:  "path command Z expects no numbers",
+		},
+		{
+			name: "numbers after relative close",
+			d:    "M0 0 L1 1 z5 5",
+			err:  "path command z expects no numbers",
+		},
+		{
+			name: "unexpected character",
+			d:    "M0 0 X1 1",
+			err:  `unexpected character 'X' in path`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			shape, err := ParsePath(tc.d)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q; got %v", tc.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !equalShapes(shape, tc.expected) {
+				t.Errorf("expected %v; got %v", tc.expected, shape)
+			}
+		})
+	}
+}
+
+func TestParsePoints(t *testing.T) {
+	shape, err := ParsePoints("0,0 10,0 10,10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !equalShapes(shape, Shape{{{0, 0}, {10, 0}, {10, 10}}}) {
+		t.Errorf("expected a triangle; got %v", shape)
+	}
+
+	if _, err := ParsePoints("0,0 10"); err == nil {
+		t.Error("expected error for an odd number of values; got nil")
+	}
+}
+
+func equalShapes(a, b Shape) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+
+		for j := range a[i] {
+			if math.Abs(a[i][j].X-b[i][j].X) > 1e-9 || math.Abs(a[i][j].Y-b[i][j].Y) > 1e-9 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/geobuff/generate/api"
 	"github.com/geobuff/generate/storage"
@@ -21,20 +23,132 @@ func main() {
 	}
 
 	listenAddr := flag.String("listenAddr", ":8081", "the server address")
+	memoryStore := flag.Bool("memoryStore", false, "use an in-memory store seeded with mock data instead of Postgres")
 	flag.Parse()
 
-	store, err := storage.NewPostgresStore(os.Getenv("CONNECTION_STRING"))
-	if err != nil {
-		panic(err)
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(os.Getenv("CONNECTION_STRING"), flag.Arg(1)); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	var store storage.IStore
+	if *memoryStore {
+		store = storage.NewSeededMemoryStore()
+	} else {
+		store, err = storage.NewPostgresStore(os.Getenv("CONNECTION_STRING"))
+		if err != nil {
+			panic(err)
+		}
 	}
 
+	cacheTTLs := storage.DefaultCacheTTLs
+	cacheTTLs.Maps = durationSeconds(os.Getenv("CACHE_MAPS_TTL_SECONDS"), cacheTTLs.Maps)
+	cacheTTLs.MappingEntries = durationSeconds(os.Getenv("CACHE_MAPPING_ENTRIES_TTL_SECONDS"), cacheTTLs.MappingEntries)
+	cacheTTLs.Categories = durationSeconds(os.Getenv("CACHE_CATEGORIES_TTL_SECONDS"), cacheTTLs.Categories)
+	store = storage.NewCachedStore(store, cacheTTLs)
+
 	rateLimiterMax, _ := strconv.ParseFloat(os.Getenv("RATE_LIMITER_MAX"), 64)
 	allowedOrigins := strings.Split(os.Getenv("CORS_ORIGINS"), ",")
 	allowedMethods := strings.Split(os.Getenv("CORS_METHODS"), ",")
 	allowedHeaders := strings.Split(os.Getenv("CORS_HEADERS"), ",")
+	hmacMaxSkewSeconds, _ := strconv.Atoi(os.Getenv("HMAC_MAX_SKEW_SECONDS"))
+	auth := api.AuthConfig{
+		APIKeys:      strings.Split(os.Getenv("API_KEYS"), ","),
+		HMACSecrets:  strings.Split(os.Getenv("HMAC_SECRETS"), ","),
+		MaxClockSkew: time.Duration(hmacMaxSkewSeconds) * time.Second,
+	}
 
 	service := utils.NewService(store)
-	server := api.NewServer(*listenAddr, rateLimiterMax, allowedOrigins, allowedMethods, allowedHeaders, service)
+	if timezone := os.Getenv("QUIZ_TIMEZONE"); timezone != "" {
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			panic(err)
+		}
+		service.SetClock(utils.NewClock(location))
+	}
+	if timeoutSeconds, _ := strconv.Atoi(os.Getenv("GENERATION_TIMEOUT_SECONDS")); timeoutSeconds > 0 {
+		service.SetGenerationTimeout(time.Duration(timeoutSeconds) * time.Second)
+	}
+	if recipesPath := os.Getenv("RECIPES_PATH"); recipesPath != "" {
+		recipes, err := utils.LoadRecipes(recipesPath)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, recipe := range recipes {
+			if err := service.AddRecipe(recipe); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if regionsPath := os.Getenv("HIGHLIGHTED_REGIONS_PATH"); regionsPath != "" {
+		regions, err := utils.LoadHighlightedRegions(regionsPath)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, region := range regions {
+			if err := service.AddHighlightedRegion(context.Background(), region); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if comparisonsPath := os.Getenv("SIZE_COMPARISONS_PATH"); comparisonsPath != "" {
+		comparisons, err := utils.LoadSizeComparisons(comparisonsPath)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, comparison := range comparisons {
+			if err := service.AddSizeComparison(context.Background(), comparison); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if strategies := os.Getenv("DISTRACTOR_STRATEGIES"); strategies != "" {
+		for _, val := range strings.Split(strategies, ",") {
+			generator, strategy, ok := strings.Cut(strings.TrimSpace(val), "=")
+			if !ok {
+				panic(fmt.Sprintf("distractor strategy %s must be in the format generator=strategy", val))
+			}
+
+			if err := service.SetDistractorStrategy(generator, strategy); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if lookaheadDays, _ := strconv.Atoi(os.Getenv("SCHEDULER_LOOKAHEAD_DAYS")); lookaheadDays > 0 {
+		maxRetries, err := strconv.Atoi(os.Getenv("SCHEDULER_MAX_RETRIES"))
+		if err != nil {
+			maxRetries = 3
+		}
+
+		service.StartScheduler(context.Background(), utils.SchedulerConfig{
+			LookaheadDays: lookaheadDays,
+			Interval:      durationSeconds(os.Getenv("SCHEDULER_INTERVAL_SECONDS"), time.Hour),
+			MaxRetries:    maxRetries,
+			RetryBackoff:  durationSeconds(os.Getenv("SCHEDULER_RETRY_BACKOFF_SECONDS"), 30*time.Second),
+			Recipe:        os.Getenv("SCHEDULER_RECIPE"),
+		})
+	}
+
+	server := api.NewServer(*listenAddr, rateLimiterMax, allowedOrigins, allowedMethods, allowedHeaders, auth, service)
 	fmt.Println("server running on port:", *listenAddr)
 	log.Fatal(server.Start())
 }
+
+// durationSeconds parses a number of seconds, returning fallback if value is
+// empty or invalid.
+func durationSeconds(value string, fallback time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return time.Duration(seconds) * time.Second
+}
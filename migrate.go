@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geobuff/generate/storage"
+)
+
+// runMigrate applies, reverts or lists the schema migrations for the database
+// at connectionString.
+func runMigrate(connectionString, command string) error {
+	store, err := storage.NewPostgresStore(connectionString)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "up":
+		applied, err := store.MigrateUp(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "down":
+		reverted, err := store.MigrateDown(ctx)
+		if reverted != nil {
+			fmt.Printf("reverted %d_%s\n", reverted.Version, reverted.Name)
+		}
+
+		if err == nil && reverted == nil {
+			fmt.Println("no applied migrations")
+		}
+		return err
+	case "status":
+		statuses, err := store.MigrationStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%d_%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", command)
+	}
+}
@@ -0,0 +1,189 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/geobuff/generate/types"
+	"github.com/patrickmn/go-cache"
+)
+
+const (
+	CACHE_KIND_MAPS            = "maps"
+	CACHE_KIND_MAPPING_ENTRIES = "mapping-entries"
+	CACHE_KIND_CATEGORIES      = "categories"
+)
+
+var CacheKinds = []string{CACHE_KIND_MAPS, CACHE_KIND_MAPPING_ENTRIES, CACHE_KIND_CATEGORIES}
+
+// CacheTTLs sets how long each kind of reference data is cached for. A zero TTL
+// keeps entries until they are invalidated.
+type CacheTTLs struct {
+	Maps           time.Duration
+	MappingEntries time.Duration
+	Categories     time.Duration
+}
+
+var DefaultCacheTTLs = CacheTTLs{
+	Maps:           24 * time.Hour,
+	MappingEntries: 24 * time.Hour,
+	Categories:     time.Hour,
+}
+
+type CacheStats struct {
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Entries int   `json:"entries"`
+}
+
+// CachedStore wraps an IStore and caches the reference data it reads: maps,
+// mapping entries and trivia question categories. Every other call goes
+// straight to the wrapped store.
+type CachedStore struct {
+	IStore
+	cache *referenceCache
+}
+
+type referenceCache struct {
+	ttls    map[string]time.Duration
+	entries map[string]*cache.Cache
+	mu      sync.Mutex
+	stats   map[string]*CacheStats
+}
+
+func NewCachedStore(store IStore, ttls CacheTTLs) *CachedStore {
+	durations := map[string]time.Duration{
+		CACHE_KIND_MAPS:            ttls.Maps,
+		CACHE_KIND_MAPPING_ENTRIES: ttls.MappingEntries,
+		CACHE_KIND_CATEGORIES:      ttls.Categories,
+	}
+
+	entries := make(map[string]*cache.Cache)
+	stats := make(map[string]*CacheStats)
+	for kind, ttl := range durations {
+		entries[kind] = cache.New(ttl, 2*ttl)
+		stats[kind] = &CacheStats{}
+	}
+
+	return &CachedStore{
+		store,
+		&referenceCache{
+			ttls:    durations,
+			entries: entries,
+			stats:   stats,
+		},
+	}
+}
+
+// WithTransaction runs fn against the transaction scoped store of the wrapped
+// store, still reading reference data through the cache.
+func (s *CachedStore) WithTransaction(ctx context.Context, fn func(store IStore) error) error {
+	return s.IStore.WithTransaction(ctx, func(store IStore) error {
+		return fn(&CachedStore{store, s.cache})
+	})
+}
+
+func (s *CachedStore) GetMap(ctx context.Context, className string) (types.MapDto, error) {
+	value, err := s.cache.get(CACHE_KIND_MAPS, className, func() (interface{}, error) {
+		return s.IStore.GetMap(ctx, className)
+	})
+	if err != nil {
+		return types.MapDto{}, err
+	}
+	return value.(types.MapDto), nil
+}
+
+func (s *CachedStore) GetMappingEntries(ctx context.Context, key string) ([]types.MappingEntryDto, error) {
+	value, err := s.cache.get(CACHE_KIND_MAPPING_ENTRIES, key, func() (interface{}, error) {
+		return s.IStore.GetMappingEntries(ctx, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := value.([]types.MappingEntryDto)
+	result := make([]types.MappingEntryDto, len(entries))
+	copy(result, entries)
+	return result, nil
+}
+
+func (s *CachedStore) GetTriviaQuestionCategories(ctx context.Context, onlyActive bool) ([]types.TriviaQuestionCategory, error) {
+	value, err := s.cache.get(CACHE_KIND_CATEGORIES, fmt.Sprint(onlyActive), func() (interface{}, error) {
+		return s.IStore.GetTriviaQuestionCategories(ctx, onlyActive)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	categories := value.([]types.TriviaQuestionCategory)
+	result := make([]types.TriviaQuestionCategory, len(categories))
+	copy(result, categories)
+	return result, nil
+}
+
+// Invalidate drops the cached entries of one kind of reference data, or of every
+// kind if kind is empty.
+func (s *CachedStore) Invalidate(kind string) error {
+	if kind == "" {
+		for _, val := range s.cache.entries {
+			val.Flush()
+		}
+		return nil
+	}
+
+	entries, ok := s.cache.entries[kind]
+	if !ok {
+		return fmt.Errorf("unknown cache kind %s", kind)
+	}
+
+	entries.Flush()
+	return nil
+}
+
+// CacheStats returns the hits, misses and cached entries for each kind of
+// reference data since the store was created.
+func (s *CachedStore) CacheStats() map[string]CacheStats {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+
+	result := make(map[string]CacheStats)
+	for kind, stats := range s.cache.stats {
+		result[kind] = CacheStats{
+			Hits:    stats.Hits,
+			Misses:  stats.Misses,
+			Entries: s.cache.entries[kind].ItemCount(),
+		}
+	}
+	return result
+}
+
+// get returns the cached value for the key or loads and caches it. Errors are
+// not cached.
+func (c *referenceCache) get(kind, key string, load func() (interface{}, error)) (interface{}, error) {
+	entries := c.entries[kind]
+	if value, ok := entries.Get(key); ok {
+		c.record(kind, true)
+		return value, nil
+	}
+
+	c.record(kind, false)
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	entries.Set(key, value, c.ttls[kind])
+	return value, nil
+}
+
+func (c *referenceCache) record(kind string, hit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if hit {
+		c.stats[kind].Hits++
+	} else {
+		c.stats[kind].Misses++
+	}
+}
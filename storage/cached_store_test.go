@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/geobuff/generate/types"
+)
+
+// countingStore counts the reference data reads that reach the wrapped store.
+type countingStore struct {
+	*MemoryStore
+	reads map[string]int
+}
+
+func (s *countingStore) WithTransaction(ctx context.Context, fn func(store IStore) error) error {
+	return s.MemoryStore.WithTransaction(ctx, func(tx IStore) error {
+		return fn(&countingStore{tx.(*MemoryStore), s.reads})
+	})
+}
+
+func (s *countingStore) GetMap(ctx context.Context, className string) (types.MapDto, error) {
+	s.reads[CACHE_KIND_MAPS]++
+	return s.MemoryStore.GetMap(ctx, className)
+}
+
+func (s *countingStore) GetMappingEntries(ctx context.Context, key string) ([]types.MappingEntryDto, error) {
+	s.reads[CACHE_KIND_MAPPING_ENTRIES]++
+	return s.MemoryStore.GetMappingEntries(ctx, key)
+}
+
+func (s *countingStore) GetTriviaQuestionCategories(ctx context.Context, onlyActive bool) ([]types.TriviaQuestionCategory, error) {
+	s.reads[CACHE_KIND_CATEGORIES]++
+	return s.MemoryStore.GetTriviaQuestionCategories(ctx, onlyActive)
+}
+
+func TestCachedStore(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingStore{NewSeededMemoryStore(), make(map[string]int)}
+	store := NewCachedStore(inner, DefaultCacheTTLs)
+
+	for i := 0; i < 3; i++ {
+		entries, err := store.GetMappingEntries(ctx, "world-countries")
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries[0].SVGName = "changed"
+	}
+
+	entries, _ := store.GetMappingEntries(ctx, "world-countries")
+	if entries[0].SVGName == "changed" {
+		t.Errorf("expected cached entries to be unaffected by callers")
+	}
+
+	store.WithTransaction(ctx, func(tx IStore) error {
+		tx.GetTriviaQuestionCategories(ctx, true)
+		tx.GetTriviaQuestionCategories(ctx, true)
+		return nil
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := store.GetMap(ctx, "unknown"); err != sql.ErrNoRows {
+			t.Fatalf("expected %v; got %v", sql.ErrNoRows, err)
+		}
+	}
+
+	expected := map[string]CacheStats{
+		CACHE_KIND_MAPPING_ENTRIES: {Hits: 3, Misses: 1, Entries: 1},
+		CACHE_KIND_CATEGORIES:      {Hits: 1, Misses: 1, Entries: 1},
+		CACHE_KIND_MAPS:            {Hits: 0, Misses: 2, Entries: 0},
+	}
+
+	stats := store.CacheStats()
+	for kind, val := range expected {
+		if stats[kind] != val {
+			t.Errorf("expected %s stats %+v; got %+v", kind, val, stats[kind])
+		}
+
+		if inner.reads[kind] != int(val.Misses) {
+			t.Errorf("expected %d %s reads; got %d", val.Misses, kind, inner.reads[kind])
+		}
+	}
+}
+
+func TestCachedStoreInvalidate(t *testing.T) {
+	tt := []struct {
+		name     string
+		kind     string
+		expected int
+	}{
+		{
+			name:     "all kinds",
+			kind:     "",
+			expected: 2,
+		},
+		{
+			name:     "matching kind",
+			kind:     CACHE_KIND_MAPPING_ENTRIES,
+			expected: 2,
+		},
+		{
+			name:     "other kind",
+			kind:     CACHE_KIND_MAPS,
+			expected: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			inner := &countingStore{NewSeededMemoryStore(), make(map[string]int)}
+			store := NewCachedStore(inner, DefaultCacheTTLs)
+
+			store.GetMappingEntries(ctx, "us-states")
+			if err := store.Invalidate(tc.kind); err != nil {
+				t.Fatal(err)
+			}
+			store.GetMappingEntries(ctx, "us-states")
+
+			if inner.reads[CACHE_KIND_MAPPING_ENTRIES] != tc.expected {
+				t.Errorf("expected %d reads; got %d", tc.expected, inner.reads[CACHE_KIND_MAPPING_ENTRIES])
+			}
+		})
+	}
+
+	if err := NewCachedStore(NewMemoryStore(), DefaultCacheTTLs).Invalidate("unknown"); err == nil {
+		t.Errorf("expected error for unknown cache kind")
+	}
+}
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrConflict is matched by errors from writes that break a constraint, such
+	// as a duplicate key.
+	ErrConflict = errors.New("conflicting data")
+	// ErrUnavailable is matched by errors from a database that cannot be reached.
+	ErrUnavailable = errors.New("database unavailable")
+)
+
+// DatabaseError is an error reported by the database or its driver. Kind is
+// ErrConflict or ErrUnavailable when the error is one of those and nil otherwise,
+// and State is the SQLSTATE code when the database returned one.
+type DatabaseError struct {
+	Kind    error
+	State   string
+	Message string
+	Err     error
+}
+
+func (e *DatabaseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DatabaseError) Is(target error) bool {
+	return e.Kind != nil && target == e.Kind
+}
+
+func (e *DatabaseError) Unwrap() error {
+	return e.Err
+}
+
+// translateError wraps driver errors in a DatabaseError so that callers can
+// classify them without depending on the driver. Other errors, including
+// sql.ErrNoRows, are returned unchanged.
+func translateError(err error) error {
+	var dbErr *DatabaseError
+	var pqErr *pq.Error
+	var netErr net.Error
+	switch {
+	case err == nil, errors.As(err, &dbErr):
+		return err
+	case errors.As(err, &pqErr):
+		dbErr = &DatabaseError{State: string(pqErr.Code), Message: pqErr.Message, Err: err}
+		switch pqErr.Code.Class() {
+		case "23":
+			dbErr.Kind = ErrConflict
+		case "08", "53", "57":
+			dbErr.Kind = ErrUnavailable
+		}
+		return dbErr
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone), errors.As(err, &netErr):
+		return &DatabaseError{Kind: ErrUnavailable, Message: err.Error(), Err: err}
+	default:
+		return err
+	}
+}
+
+// database runs queries against a queryer and translates the errors they return.
+type database struct {
+	conn queryer
+}
+
+func (d database) QueryContext(ctx context.Context, query string, args ...interface{}) (*rows, error) {
+	result, err := d.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &rows{result}, nil
+}
+
+func (d database) QueryRowContext(ctx context.Context, query string, args ...interface{}) *row {
+	return &row{d.conn.QueryRowContext(ctx, query, args...)}
+}
+
+type rows struct {
+	*sql.Rows
+}
+
+func (r *rows) Scan(dest ...interface{}) error {
+	return translateError(r.Rows.Scan(dest...))
+}
+
+func (r *rows) Err() error {
+	return translateError(r.Rows.Err())
+}
+
+type row struct {
+	*sql.Row
+}
+
+func (r *row) Scan(dest ...interface{}) error {
+	return translateError(r.Row.Scan(dest...))
+}
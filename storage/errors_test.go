@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestTranslateError(t *testing.T) {
+	tt := []struct {
+		name     string
+		err      error
+		kind     error
+		state    string
+		database bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+		},
+		{
+			name: "no rows",
+			err:  sql.ErrNoRows,
+		},
+		{
+			name:     "unique violation",
+			err:      &pq.Error{Code: "23505", Message: "duplicate key"},
+			kind:     ErrConflict,
+			state:    "23505",
+			database: true,
+		},
+		{
+			name:     "connection failure",
+			err:      &pq.Error{Code: "08006", Message: "connection failure"},
+			kind:     ErrUnavailable,
+			state:    "08006",
+			database: true,
+		},
+		{
+			name:     "syntax error",
+			err:      &pq.Error{Code: "42601", Message: "syntax error"},
+			state:    "42601",
+			database: true,
+		},
+		{
+			name:     "bad connection",
+			err:      fmt.Errorf("query: %w", driver.ErrBadConn),
+			kind:     ErrUnavailable,
+			database: true,
+		},
+		{
+			name: "unexpected error",
+			err:  errors.New("test"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := translateError(tc.err)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("expected %v to wrap %v", err, tc.err)
+			}
+
+			var dbErr *DatabaseError
+			if errors.As(err, &dbErr) != tc.database {
+				t.Fatalf("expected database error %v; got %v", tc.database, err)
+			}
+
+			if !tc.database {
+				return
+			}
+
+			if dbErr.Kind != tc.kind || dbErr.State != tc.state {
+				t.Errorf("expected kind %v and state %q; got %v and %q", tc.kind, tc.state, dbErr.Kind, dbErr.State)
+			}
+
+			if tc.kind != nil && !errors.Is(err, tc.kind) {
+				t.Errorf("expected %v to match %v", err, tc.kind)
+			}
+
+			if translateError(err) != err {
+				t.Error("expected a translated error to be returned unchanged")
+			}
+		})
+	}
+}
@@ -0,0 +1,692 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/geobuff/generate/types"
+)
+
+var questionTypeNames = map[int]string{
+	types.QUESTION_TYPE_TEXT:  "text",
+	types.QUESTION_TYPE_IMAGE: "image",
+	types.QUESTION_TYPE_FLAG:  "flag",
+	types.QUESTION_TYPE_MAP:   "map",
+}
+
+// MemoryStore is an IStore that keeps everything in memory. Missing rows are
+// reported with sql.ErrNoRows, the same as PostgresStore.
+//
+// A transaction works on a copy of the data and records each change it makes.
+// On commit the changes are replayed onto the shared data. On rollback the copy
+// is discarded.
+type MemoryStore struct {
+	mu            *sync.Mutex
+	state         *memoryState
+	sequences     map[string]int
+	lockedDates   map[string]bool
+	heldDates     []string
+	journal       []func(state *memoryState) error
+	inTransaction bool
+}
+
+type memoryState struct {
+	trivia          map[int]types.TriviaDto
+	questions       map[int]types.TriviaQuestion
+	answers         map[int]types.TriviaAnswer
+	manualQuestions map[int]types.ManualTriviaQuestion
+	manualAnswers   map[int]types.ManualTriviaAnswer
+	categories      []types.TriviaQuestionCategory
+	maps            map[string]types.MapDto
+	mappingEntries  map[string][]types.MappingEntryDto
+	flagGroups      []types.FlagSimilarityGroup
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		mu: &sync.Mutex{},
+		state: &memoryState{
+			trivia:          make(map[int]types.TriviaDto),
+			questions:       make(map[int]types.TriviaQuestion),
+			answers:         make(map[int]types.TriviaAnswer),
+			manualQuestions: make(map[int]types.ManualTriviaQuestion),
+			manualAnswers:   make(map[int]types.ManualTriviaAnswer),
+			maps:            make(map[string]types.MapDto),
+			mappingEntries:  make(map[string][]types.MappingEntryDto),
+		},
+		sequences:   make(map[string]int),
+		lockedDates: make(map[string]bool),
+	}
+}
+
+// NewSeededMemoryStore returns a MemoryStore holding the mock mapping entries,
+// categories, maps, flag similarity groups and manual questions.
+func NewSeededMemoryStore() *MemoryStore {
+	store := NewMemoryStore()
+	store.AddMappingEntries("world-countries", countries)
+	store.AddMappingEntries("world-capitals", capitals)
+	store.AddMappingEntries("us-states", states)
+
+	for _, category := range questionCategories {
+		store.AddTriviaQuestionCategory(category)
+	}
+
+	for _, svgMap := range maps {
+		store.AddMap(svgMap)
+	}
+
+	for _, group := range flagSimilarityGroups {
+		store.AddFlagSimilarityGroup(group)
+	}
+
+	for _, question := range manualQuestions {
+		store.AddManualTriviaQuestion(question.question, question.answers)
+	}
+	return store
+}
+
+func (s *memoryState) clone() *memoryState {
+	result := &memoryState{
+		trivia:          make(map[int]types.TriviaDto, len(s.trivia)),
+		questions:       make(map[int]types.TriviaQuestion, len(s.questions)),
+		answers:         make(map[int]types.TriviaAnswer, len(s.answers)),
+		manualQuestions: make(map[int]types.ManualTriviaQuestion, len(s.manualQuestions)),
+		manualAnswers:   make(map[int]types.ManualTriviaAnswer, len(s.manualAnswers)),
+		categories:      append([]types.TriviaQuestionCategory{}, s.categories...),
+		flagGroups:      append([]types.FlagSimilarityGroup{}, s.flagGroups...),
+		maps:            make(map[string]types.MapDto, len(s.maps)),
+		mappingEntries:  make(map[string][]types.MappingEntryDto, len(s.mappingEntries)),
+	}
+
+	for id, val := range s.trivia {
+		result.trivia[id] = val
+	}
+	for id, val := range s.questions {
+		result.questions[id] = val
+	}
+	for id, val := range s.answers {
+		result.answers[id] = val
+	}
+	for id, val := range s.manualQuestions {
+		result.manualQuestions[id] = val
+	}
+	for id, val := range s.manualAnswers {
+		result.manualAnswers[id] = val
+	}
+	for key, val := range s.maps {
+		result.maps[key] = val
+	}
+	for key, val := range s.mappingEntries {
+		result.mappingEntries[key] = val
+	}
+	return result
+}
+
+// nextID returns the next id for the table. Ids used by a transaction that is
+// rolled back are not reused, matching a Postgres sequence.
+func (s *MemoryStore) nextID(table string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sequences[table]++
+	return s.sequences[table]
+}
+
+// apply makes a change to the store, recording it to be replayed on commit when
+// the store is scoped to a transaction.
+func (s *MemoryStore) apply(change func(state *memoryState) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := change(s.state); err != nil {
+		return err
+	}
+
+	if s.inTransaction {
+		s.journal = append(s.journal, change)
+	}
+	return nil
+}
+
+func (s *MemoryStore) read(fn func(state *memoryState) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.state)
+}
+
+func (s *MemoryStore) AddMappingEntries(key string, entries []types.MappingEntryDto) {
+	s.apply(func(state *memoryState) error {
+		state.mappingEntries[key] = append(state.mappingEntries[key], entries...)
+		return nil
+	})
+}
+
+func (s *MemoryStore) AddTriviaQuestionCategory(category types.TriviaQuestionCategory) {
+	s.apply(func(state *memoryState) error {
+		state.categories = append(state.categories, category)
+		return nil
+	})
+}
+
+func (s *MemoryStore) AddMap(svgMap types.MapDto) {
+	s.apply(func(state *memoryState) error {
+		state.maps[svgMap.ClassName] = svgMap
+		return nil
+	})
+}
+
+func (s *MemoryStore) AddFlagSimilarityGroup(group types.FlagSimilarityGroup) {
+	s.apply(func(state *memoryState) error {
+		state.flagGroups = append(state.flagGroups, group)
+		return nil
+	})
+}
+
+// AddManualTriviaQuestion stores a manual question with its answers and returns
+// the id given to the question.
+func (s *MemoryStore) AddManualTriviaQuestion(question types.ManualTriviaQuestion, answers []types.ManualTriviaAnswer) int {
+	question.ID = s.nextID("manualtriviaquestions")
+	answers = append([]types.ManualTriviaAnswer{}, answers...)
+	for i := range answers {
+		answers[i].ID = s.nextID("manualtriviaanswers")
+		answers[i].ManualTriviaQuestionID = question.ID
+	}
+
+	s.apply(func(state *memoryState) error {
+		state.manualQuestions[question.ID] = question
+		for _, answer := range answers {
+			state.manualAnswers[answer.ID] = answer
+		}
+		return nil
+	})
+	return question.ID
+}
+
+func (s *MemoryStore) WithTransaction(ctx context.Context, fn func(store IStore) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if s.inTransaction {
+		return fn(s)
+	}
+
+	s.mu.Lock()
+	tx := &MemoryStore{
+		mu:            s.mu,
+		state:         s.state.clone(),
+		sequences:     s.sequences,
+		lockedDates:   s.lockedDates,
+		inTransaction: true,
+	}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, date := range tx.heldDates {
+			delete(s.lockedDates, date)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, change := range tx.journal {
+		change(s.state)
+	}
+	return nil
+}
+
+func (s *MemoryStore) LockTriviaDate(ctx context.Context, date time.Time) (bool, error) {
+	if !s.inTransaction {
+		return false, errors.New("trivia date locks can only be taken inside a transaction")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := date.Format("2006-01-02")
+	for _, held := range s.heldDates {
+		if held == key {
+			return true, nil
+		}
+	}
+
+	if s.lockedDates[key] {
+		return false, nil
+	}
+
+	s.lockedDates[key] = true
+	s.heldDates = append(s.heldDates, key)
+	return true, nil
+}
+
+// ClearTriviaPlayTriviaId always reports no rows as the memory store does not
+// record trivia plays.
+func (s *MemoryStore) ClearTriviaPlayTriviaId(ctx context.Context, triviaId int) error {
+	return sql.ErrNoRows
+}
+
+func (s *MemoryStore) DeleteTriviaAnswers(ctx context.Context, triviaQuestionId int) error {
+	return s.apply(func(state *memoryState) error {
+		deleted := false
+		for id, answer := range state.answers {
+			if answer.TriviaQuestionID == triviaQuestionId {
+				delete(state.answers, id)
+				deleted = true
+			}
+		}
+
+		if !deleted {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+}
+
+func (s *MemoryStore) DeleteTriviaQuestion(ctx context.Context, questionId int) error {
+	return s.apply(func(state *memoryState) error {
+		if _, ok := state.questions[questionId]; !ok {
+			return sql.ErrNoRows
+		}
+		delete(state.questions, questionId)
+		return nil
+	})
+}
+
+func (s *MemoryStore) DeleteTrivia(ctx context.Context, trivia *types.TriviaDto) error {
+	return s.apply(func(state *memoryState) error {
+		if _, ok := state.trivia[trivia.ID]; !ok {
+			return sql.ErrNoRows
+		}
+
+		for id, question := range state.questions {
+			if question.TriviaId != trivia.ID {
+				continue
+			}
+
+			for answerID, answer := range state.answers {
+				if answer.TriviaQuestionID == id {
+					delete(state.answers, answerID)
+				}
+			}
+			delete(state.questions, id)
+		}
+
+		delete(state.trivia, trivia.ID)
+		return nil
+	})
+}
+
+// GetTrivia returns the trivia for the date with its questions and answers in the
+// order they were created.
+func (s *MemoryStore) GetTrivia(ctx context.Context, date string) (*types.TriviaDto, error) {
+	var result *types.TriviaDto
+	err := s.read(func(state *memoryState) error {
+		trivia, ok := state.triviaForDate(date)
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		trivia.Questions = []types.QuestionDto{}
+		for _, question := range sortedQuestions(state.questions) {
+			if question.TriviaId != trivia.ID {
+				continue
+			}
+
+			dto, err := state.questionDto(question)
+			if err != nil {
+				return err
+			}
+			trivia.Questions = append(trivia.Questions, dto)
+		}
+
+		result = &trivia
+		return nil
+	})
+	return result, err
+}
+
+func (s *memoryState) triviaForDate(date string) (types.TriviaDto, bool) {
+	for _, trivia := range s.trivia {
+		if trivia.Date.Format("2006-01-02") == date {
+			return trivia, true
+		}
+	}
+	return types.TriviaDto{}, false
+}
+
+func (s *memoryState) questionDto(question types.TriviaQuestion) (types.QuestionDto, error) {
+	dto := types.QuestionDto{
+		ID:                 question.ID,
+		Type:               questionTypeNames[question.TypeID],
+		Question:           question.Question,
+		MapName:            question.Map,
+		Highlighted:        question.Highlighted,
+		FlagCode:           question.FlagCode,
+		FlagUrl:            s.flagUrl(question.FlagCode),
+		ImageURL:           question.ImageURL,
+		ImageAttributeName: question.ImageAttributeName,
+		ImageAttributeURL:  question.ImageAttributeURL,
+		ImageWidth:         question.ImageWidth,
+		ImageHeight:        question.ImageHeight,
+		ImageAlt:           question.ImageAlt,
+		Explainer:          question.Explainer,
+		Answers:            []types.AnswerDto{},
+	}
+
+	for _, answer := range sortedAnswers(s.answers) {
+		if answer.TriviaQuestionID == question.ID {
+			dto.Answers = append(dto.Answers, types.AnswerDto{
+				Text:      answer.Text,
+				IsCorrect: answer.IsCorrect,
+				FlagCode:  answer.FlagCode,
+				FlagUrl:   s.flagUrl(answer.FlagCode),
+			})
+		}
+	}
+	return dto, nil
+}
+
+func (s *memoryState) flagUrl(code string) sql.NullString {
+	if code == "" {
+		return sql.NullString{}
+	}
+
+	for _, entries := range s.mappingEntries {
+		for _, entry := range entries {
+			if entry.Code == code && entry.FlagUrl != "" {
+				return sql.NullString{String: entry.FlagUrl, Valid: true}
+			}
+		}
+	}
+	return sql.NullString{}
+}
+
+func sortedQuestions(questions map[int]types.TriviaQuestion) []types.TriviaQuestion {
+	result := make([]types.TriviaQuestion, 0, len(questions))
+	for _, question := range questions {
+		result = append(result, question)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+func sortedAnswers(answers map[int]types.TriviaAnswer) []types.TriviaAnswer {
+	result := make([]types.TriviaAnswer, 0, len(answers))
+	for _, answer := range answers {
+		result = append(result, answer)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+func sortedManualQuestions(questions map[int]types.ManualTriviaQuestion) []types.ManualTriviaQuestion {
+	result := make([]types.ManualTriviaQuestion, 0, len(questions))
+	for _, question := range questions {
+		result = append(result, question)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+func (s *MemoryStore) GetAllTrivia(ctx context.Context, filter types.GetTriviaFilter) ([]types.TriviaDto, error) {
+	var trivia = []types.TriviaDto{}
+	err := s.read(func(state *memoryState) error {
+		for _, t := range state.trivia {
+			date := t.Date.Format("2006-01-02")
+			if (filter.From == "" || date >= filter.From) && (filter.To == "" || date <= filter.To) {
+				trivia = append(trivia, t)
+			}
+		}
+		return nil
+	})
+
+	sort.Slice(trivia, func(i, j int) bool { return trivia[i].Date.After(trivia[j].Date) })
+
+	offset := filter.Page * filter.Limit
+	if offset > len(trivia) {
+		offset = len(trivia)
+	}
+
+	end := offset + filter.Limit + 1
+	if end > len(trivia) {
+		end = len(trivia)
+	}
+	return trivia[offset:end], err
+}
+
+func (s *MemoryStore) GetMap(ctx context.Context, className string) (types.MapDto, error) {
+	var result types.MapDto
+	err := s.read(func(state *memoryState) error {
+		svgMap, ok := state.maps[className]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		result = svgMap
+		return nil
+	})
+	return result, err
+}
+
+func (s *MemoryStore) SetTriviaMaxScore(ctx context.Context, triviaID, maxScore int) error {
+	return s.apply(func(state *memoryState) error {
+		trivia, ok := state.trivia[triviaID]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		trivia.MaxScore = maxScore
+		state.trivia[triviaID] = trivia
+		return nil
+	})
+}
+
+func (s *MemoryStore) GetMappingEntries(ctx context.Context, key string) ([]types.MappingEntryDto, error) {
+	var entries = []types.MappingEntryDto{}
+	err := s.read(func(state *memoryState) error {
+		entries = append(entries, state.mappingEntries[key]...)
+		return nil
+	})
+	return entries, err
+}
+
+func (s *MemoryStore) GetFlagSimilarityGroups(ctx context.Context) ([]types.FlagSimilarityGroup, error) {
+	var groups = []types.FlagSimilarityGroup{}
+	err := s.read(func(state *memoryState) error {
+		groups = append(groups, state.flagGroups...)
+		return nil
+	})
+	return groups, err
+}
+
+func (s *MemoryStore) GetManualTriviaQuestionsForDate(ctx context.Context, quizDate string) ([]types.ManualTriviaQuestion, error) {
+	var questions = []types.ManualTriviaQuestion{}
+	err := s.read(func(state *memoryState) error {
+		for _, question := range sortedManualQuestions(state.manualQuestions) {
+			if question.QuizDate.Valid && question.QuizDate.Time.Format("2006-01-02") == quizDate {
+				questions = append(questions, question)
+			}
+		}
+		return nil
+	})
+	return questions, err
+}
+
+func (s *MemoryStore) GetTriviaQuestionCategories(ctx context.Context, onlyActive bool) ([]types.TriviaQuestionCategory, error) {
+	var categories = []types.TriviaQuestionCategory{}
+	err := s.read(func(state *memoryState) error {
+		for _, category := range state.categories {
+			if !onlyActive || category.IsActive {
+				categories = append(categories, category)
+			}
+		}
+		return nil
+	})
+	return categories, err
+}
+
+func (s *MemoryStore) GetTriviaQuestion(ctx context.Context, questionID int) (types.TriviaQuestion, error) {
+	var result types.TriviaQuestion
+	err := s.read(func(state *memoryState) error {
+		question, ok := state.questions[questionID]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		result = question
+		return nil
+	})
+	return result, err
+}
+
+func (s *MemoryStore) CreateTriviaQuestion(ctx context.Context, question types.TriviaQuestion) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	question.ID = s.nextID("triviaquestions")
+	err := s.apply(func(state *memoryState) error {
+		if _, ok := state.trivia[question.TriviaId]; !ok {
+			return errors.New("trivia question references a trivia that does not exist")
+		}
+
+		state.questions[question.ID] = question
+		return nil
+	})
+	return question.ID, err
+}
+
+func (s *MemoryStore) CreateTriviaAnswer(ctx context.Context, answer types.TriviaAnswer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	answer.ID = s.nextID("triviaanswers")
+	return s.apply(func(state *memoryState) error {
+		if _, ok := state.questions[answer.TriviaQuestionID]; !ok {
+			return errors.New("trivia answer references a question that does not exist")
+		}
+
+		state.answers[answer.ID] = answer
+		return nil
+	})
+}
+
+// GetManualTriviaQuestions returns at most one unscheduled question per allowed
+// category that has not been used since lastUsedMax.
+func (s *MemoryStore) GetManualTriviaQuestions(ctx context.Context, typeID int, lastUsedMax string, allowedCategories []int) ([]types.ManualTriviaQuestion, error) {
+	allowed := make(map[int]bool)
+	for _, id := range allowedCategories {
+		allowed[id] = true
+	}
+
+	var questions = []types.ManualTriviaQuestion{}
+	err := s.read(func(state *memoryState) error {
+		seen := make(map[int]bool)
+		for _, question := range sortedManualQuestions(state.manualQuestions) {
+			if question.TypeID != typeID || question.QuizDate.Valid || !allowed[question.CategoryID] || seen[question.CategoryID] {
+				continue
+			}
+
+			if question.LastUsed.Valid && question.LastUsed.Time.Format("2006-01-02") >= lastUsedMax {
+				continue
+			}
+
+			seen[question.CategoryID] = true
+			questions = append(questions, question)
+		}
+		return nil
+	})
+	return questions, err
+}
+
+func (s *MemoryStore) GetManualTriviaQuestion(ctx context.Context, questionID int) (types.ManualTriviaQuestion, error) {
+	var result types.ManualTriviaQuestion
+	err := s.read(func(state *memoryState) error {
+		question, ok := state.manualQuestions[questionID]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		result = question
+		return nil
+	})
+	return result, err
+}
+
+func (s *MemoryStore) GetManualTriviaAnswers(ctx context.Context, questionID int) ([]types.ManualTriviaAnswer, error) {
+	var answers = []types.ManualTriviaAnswer{}
+	err := s.read(func(state *memoryState) error {
+		for _, answer := range state.manualAnswers {
+			if answer.ManualTriviaQuestionID == questionID {
+				answers = append(answers, answer)
+			}
+		}
+		return nil
+	})
+
+	sort.Slice(answers, func(i, j int) bool { return answers[i].ID < answers[j].ID })
+	return answers, err
+}
+
+func (s *MemoryStore) UpdateManualTriviaQuestionLastUsed(ctx context.Context, questionID int, quizDate string) error {
+	lastUsed, err := time.Parse("2006-01-02", quizDate)
+	if err != nil {
+		return err
+	}
+
+	return s.apply(func(state *memoryState) error {
+		question, ok := state.manualQuestions[questionID]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		if question.LastUsed.Valid && question.LastUsed.Time.After(lastUsed) {
+			return nil
+		}
+
+		question.LastUsed = sql.NullTime{Time: lastUsed, Valid: true}
+		state.manualQuestions[questionID] = question
+		return nil
+	})
+}
+
+func (s *MemoryStore) TriviaDoesNotExistForDate(ctx context.Context, date time.Time) (bool, error) {
+	var exists bool
+	err := s.read(func(state *memoryState) error {
+		_, exists = state.triviaForDate(date.Format("2006-01-02"))
+		return nil
+	})
+	return !exists, err
+}
+
+func (s *MemoryStore) CreateTrivia(ctx context.Context, name string, date time.Time) (int, error) {
+	year, month, day := date.Date()
+	trivia := types.TriviaDto{
+		ID:   s.nextID("trivia"),
+		Name: name,
+		Date: time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+	}
+
+	err := s.apply(func(state *memoryState) error {
+		if _, exists := state.triviaForDate(trivia.Date.Format("2006-01-02")); exists {
+			return errors.New("trivia already exists for date")
+		}
+
+		state.trivia[trivia.ID] = trivia
+		return nil
+	})
+	return trivia.ID, err
+}
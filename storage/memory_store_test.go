@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geobuff/generate/types"
+)
+
+func TestMemoryStoreWithTransaction(t *testing.T) {
+	tt := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{
+			name:     "rolls back on error",
+			err:      errors.New("test"),
+			expected: sql.ErrNoRows,
+		},
+		{
+			name:     "commits on success",
+			err:      nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := NewMemoryStore()
+			date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			err := store.WithTransaction(ctx, func(tx IStore) error {
+				if _, err := tx.CreateTrivia(ctx, "test", date); err != nil {
+					t.Fatal(err)
+				}
+
+				if _, err := store.GetTrivia(ctx, "2022-01-01"); err != sql.ErrNoRows {
+					t.Errorf("expected trivia to be hidden until commit; got %v", err)
+				}
+				return tc.err
+			})
+
+			if err != tc.err {
+				t.Fatalf("expected error %v; got %v", tc.err, err)
+			}
+
+			if _, err := store.GetTrivia(ctx, "2022-01-01"); err != tc.expected {
+				t.Errorf("expected error %v; got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreGetManualTriviaQuestions(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	quizDate := time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)
+	recent := sql.NullTime{Time: quizDate.AddDate(0, 0, -1), Valid: true}
+	old := sql.NullTime{Time: quizDate.AddDate(0, 0, -30), Valid: true}
+	scheduled := sql.NullTime{Time: quizDate, Valid: true}
+
+	unused := store.AddManualTriviaQuestion(types.ManualTriviaQuestion{TypeID: types.QUESTION_TYPE_TEXT, CategoryID: 1}, nil)
+	store.AddManualTriviaQuestion(types.ManualTriviaQuestion{TypeID: types.QUESTION_TYPE_TEXT, CategoryID: 1}, nil)
+	store.AddManualTriviaQuestion(types.ManualTriviaQuestion{TypeID: types.QUESTION_TYPE_TEXT, CategoryID: 2, LastUsed: recent}, nil)
+	usedLongAgo := store.AddManualTriviaQuestion(types.ManualTriviaQuestion{TypeID: types.QUESTION_TYPE_TEXT, CategoryID: 3, LastUsed: old}, nil)
+	store.AddManualTriviaQuestion(types.ManualTriviaQuestion{TypeID: types.QUESTION_TYPE_TEXT, CategoryID: 4, QuizDate: scheduled}, nil)
+	store.AddManualTriviaQuestion(types.ManualTriviaQuestion{TypeID: types.QUESTION_TYPE_IMAGE, CategoryID: 5}, nil)
+
+	lastUsedMax := quizDate.AddDate(0, 0, -7).Format("2006-01-02")
+	questions, err := store.GetManualTriviaQuestions(ctx, types.QUESTION_TYPE_TEXT, lastUsedMax, []int{1, 2, 3, 4, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(questions) != 2 || questions[0].ID != unused || questions[1].ID != usedLongAgo {
+		t.Fatalf("expected questions %d and %d; got %+v", unused, usedLongAgo, questions)
+	}
+
+	if err := store.UpdateManualTriviaQuestionLastUsed(ctx, unused, quizDate.Format("2006-01-02")); err != nil {
+		t.Fatal(err)
+	}
+
+	questions, err = store.GetManualTriviaQuestions(ctx, types.QUESTION_TYPE_TEXT, lastUsedMax, []int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(questions) != 1 || questions[0].ID == unused {
+		t.Errorf("expected a question other than %d once it was used; got %+v", unused, questions)
+	}
+
+	forDate, err := store.GetManualTriviaQuestionsForDate(ctx, quizDate.Format("2006-01-02"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(forDate) != 1 || forDate[0].CategoryID != 4 {
+		t.Errorf("expected the question scheduled for the quiz date; got %+v", forDate)
+	}
+
+	dayBefore, err := store.GetManualTriviaQuestionsForDate(ctx, quizDate.AddDate(0, 0, -1).Format("2006-01-02"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dayBefore) != 0 {
+		t.Errorf("expected no questions scheduled for the day before; got %+v", dayBefore)
+	}
+}
@@ -0,0 +1,255 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockNamespace is the advisory lock key held while applying or
+// reverting a migration so two instances cannot migrate at the same time.
+const migrationLockNamespace = 1835627378
+
+// Migration is a versioned schema change. Migrations are read from files named
+// <version>_<name>.up.sql with an optional matching .down.sql. A migration without
+// a down file cannot be reverted.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// LoadMigrations returns the embedded migrations ordered by version.
+func LoadMigrations() ([]Migration, error) {
+	return loadMigrations(migrationFiles, "migrations")
+}
+
+func loadMigrations(files fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make(map[int]*Migration)
+	for _, entry := range entries {
+		var direction string
+		fileName := entry.Name()
+		switch {
+		case strings.HasSuffix(fileName, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(fileName, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		parts := strings.SplitN(base, "_", 2)
+		version, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts) != 2 || version <= 0 {
+			return nil, fmt.Errorf("migration file %s must be named <version>_<name>.%s.sql", fileName, direction)
+		}
+
+		contents, err := fs.ReadFile(files, dir+"/"+fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := migrations[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: parts[1]}
+			migrations[version] = migration
+		}
+
+		if migration.Name != parts[1] {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, migration.Name, parts[1])
+		}
+
+		if direction == "up" {
+			migration.Up = string(contents)
+		} else {
+			migration.Down = string(contents)
+		}
+	}
+
+	var result []Migration
+	for _, migration := range migrations {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+		result = append(result, *migration)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+func (s *PostgresStore) ensureMigrationsTable(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY, name TEXT NOT NULL, appliedAt TIMESTAMP NOT NULL DEFAULT now());")
+	return err
+}
+
+func (s *PostgresStore) appliedMigrations(ctx context.Context, connection queryer) (map[int]time.Time, error) {
+	rows, err := connection.QueryContext(ctx, "SELECT version, appliedAt FROM schema_migrations;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err = rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// MigrationStatus returns every known migration and when it was applied.
+func (s *PostgresStore) MigrationStatus(ctx context.Context) ([]MigrationStatus, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureMigrationsTable(ctx); err != nil {
+		return nil, err
+	}
+
+	applied, err := s.appliedMigrations(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []MigrationStatus
+	for _, migration := range migrations {
+		status := MigrationStatus{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+// MigrateUp applies every pending migration in order, each in its own
+// transaction, and returns the migrations it applied.
+func (s *PostgresStore) MigrateUp(ctx context.Context) ([]Migration, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureMigrationsTable(ctx); err != nil {
+		return nil, err
+	}
+
+	var result []Migration
+	for _, migration := range migrations {
+		migration := migration
+		applied, err := s.inMigrationLock(ctx, func(tx *sql.Tx, applied map[int]time.Time) (bool, error) {
+			if _, ok := applied[migration.Version]; ok {
+				return false, nil
+			}
+
+			if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+				return false, fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+
+			_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2);", migration.Version, migration.Name)
+			return true, err
+		})
+
+		if err != nil {
+			return result, err
+		}
+
+		if applied {
+			result = append(result, migration)
+		}
+	}
+	return result, nil
+}
+
+// MigrateDown reverts the most recently applied migration and returns it, or nil
+// if no migrations have been applied.
+func (s *PostgresStore) MigrateDown(ctx context.Context) (*Migration, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureMigrationsTable(ctx); err != nil {
+		return nil, err
+	}
+
+	var reverted *Migration
+	_, err = s.inMigrationLock(ctx, func(tx *sql.Tx, applied map[int]time.Time) (bool, error) {
+		for i := len(migrations) - 1; i >= 0; i-- {
+			migration := migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+
+			if migration.Down == "" {
+				return false, fmt.Errorf("migration %d_%s cannot be reverted", migration.Version, migration.Name)
+			}
+
+			if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+				return false, fmt.Errorf("reverting migration %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+
+			if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1;", migration.Version); err != nil {
+				return false, err
+			}
+
+			reverted = &migration
+			return true, nil
+		}
+		return false, nil
+	})
+	return reverted, err
+}
+
+// inMigrationLock runs fn in a transaction holding the migration lock, passing
+// the migrations applied at the time the lock was taken.
+func (s *PostgresStore) inMigrationLock(ctx context.Context, fn func(tx *sql.Tx, applied map[int]time.Time) (bool, error)) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1);", migrationLockNamespace); err != nil {
+		return false, err
+	}
+
+	applied, err := s.appliedMigrations(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
+	changed, err := fn(tx, applied)
+	if err != nil {
+		return false, err
+	}
+	return changed, tx.Commit()
+}
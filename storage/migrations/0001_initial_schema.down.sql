@@ -0,0 +1,17 @@
+-- Drops every table created by the initial schema, dependants first. This
+-- removes all data, including any that predates the migrations.
+
+DROP TABLE IF EXISTS triviaPlays;
+DROP TABLE IF EXISTS triviaAnswers;
+DROP TABLE IF EXISTS triviaQuestions;
+DROP TABLE IF EXISTS trivia;
+DROP TABLE IF EXISTS manualTriviaAnswers;
+DROP TABLE IF EXISTS manualTriviaQuestions;
+DROP TABLE IF EXISTS mapElements;
+DROP TABLE IF EXISTS mapElementType;
+DROP TABLE IF EXISTS maps;
+DROP TABLE IF EXISTS flagEntries;
+DROP TABLE IF EXISTS mappingEntries;
+DROP TABLE IF EXISTS mappingGroups;
+DROP TABLE IF EXISTS triviaQuestionCategory;
+DROP TABLE IF EXISTS triviaQuestionType;
@@ -0,0 +1,157 @@
+-- The tables the service reads and writes. Every statement is guarded so that
+-- the migration can be recorded against a database created before migrations
+-- were kept in this repository.
+
+CREATE TABLE IF NOT EXISTS triviaQuestionType (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO triviaQuestionType (id, name) VALUES
+    (1, 'text'),
+    (2, 'image'),
+    (3, 'flag'),
+    (4, 'map')
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS triviaQuestionCategory (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    isActive BOOLEAN NOT NULL DEFAULT TRUE,
+    imageOnly BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS mappingGroups (
+    id SERIAL PRIMARY KEY,
+    key TEXT NOT NULL UNIQUE,
+    label TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS mappingEntries (
+    id SERIAL PRIMARY KEY,
+    groupId INTEGER NOT NULL REFERENCES mappingGroups (id),
+    name TEXT NOT NULL,
+    code TEXT NOT NULL,
+    svgName TEXT NOT NULL,
+    alternativeNames TEXT[] NOT NULL DEFAULT '{}',
+    prefixes TEXT[] NOT NULL DEFAULT '{}',
+    grouping TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS mappingEntries_groupId_idx ON mappingEntries (groupId);
+
+CREATE TABLE IF NOT EXISTS flagEntries (
+    id SERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    url TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS maps (
+    id SERIAL PRIMARY KEY,
+    key TEXT NOT NULL UNIQUE,
+    className TEXT NOT NULL UNIQUE,
+    label TEXT NOT NULL,
+    viewBox TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS mapElementType (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS mapElements (
+    id SERIAL PRIMARY KEY,
+    mapId INTEGER NOT NULL REFERENCES maps (id),
+    typeId INTEGER NOT NULL REFERENCES mapElementType (id),
+    elementId TEXT NOT NULL DEFAULT '',
+    name TEXT NOT NULL DEFAULT '',
+    d TEXT NOT NULL DEFAULT '',
+    points TEXT NOT NULL DEFAULT '',
+    x TEXT NOT NULL DEFAULT '',
+    y TEXT NOT NULL DEFAULT '',
+    width TEXT NOT NULL DEFAULT '',
+    height TEXT NOT NULL DEFAULT '',
+    cx TEXT NOT NULL DEFAULT '',
+    cy TEXT NOT NULL DEFAULT '',
+    r TEXT NOT NULL DEFAULT '',
+    transform TEXT NOT NULL DEFAULT '',
+    xlinkHref TEXT NOT NULL DEFAULT '',
+    clipPath TEXT NOT NULL DEFAULT '',
+    clipPathId TEXT NOT NULL DEFAULT '',
+    x1 TEXT NOT NULL DEFAULT '',
+    y1 TEXT NOT NULL DEFAULT '',
+    x2 TEXT NOT NULL DEFAULT '',
+    y2 TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS mapElements_mapId_idx ON mapElements (mapId);
+
+CREATE TABLE IF NOT EXISTS manualTriviaQuestions (
+    id SERIAL PRIMARY KEY,
+    typeId INTEGER NOT NULL REFERENCES triviaQuestionType (id),
+    question TEXT NOT NULL,
+    map TEXT NOT NULL DEFAULT '',
+    highlighted TEXT NOT NULL DEFAULT '',
+    flagCode TEXT NOT NULL DEFAULT '',
+    imageUrl TEXT NOT NULL DEFAULT '',
+    lastUsed DATE,
+    quizDate DATE,
+    explainer TEXT NOT NULL DEFAULT '',
+    lastUpdated TIMESTAMP NOT NULL DEFAULT now(),
+    categoryId INTEGER NOT NULL REFERENCES triviaQuestionCategory (id),
+    imageAttributeName TEXT NOT NULL DEFAULT '',
+    imageAttributeUrl TEXT NOT NULL DEFAULT '',
+    imageWidth INTEGER NOT NULL DEFAULT 0,
+    imageHeight INTEGER NOT NULL DEFAULT 0,
+    imageAlt TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS manualTriviaAnswers (
+    id SERIAL PRIMARY KEY,
+    manualTriviaQuestionId INTEGER NOT NULL REFERENCES manualTriviaQuestions (id) ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    isCorrect BOOLEAN NOT NULL DEFAULT FALSE,
+    flagCode TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS trivia (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    date DATE NOT NULL UNIQUE,
+    maxScore INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS triviaQuestions (
+    id SERIAL PRIMARY KEY,
+    triviaId INTEGER NOT NULL REFERENCES trivia (id),
+    typeId INTEGER NOT NULL REFERENCES triviaQuestionType (id),
+    question TEXT NOT NULL,
+    map TEXT NOT NULL DEFAULT '',
+    highlighted TEXT NOT NULL DEFAULT '',
+    flagCode TEXT NOT NULL DEFAULT '',
+    imageUrl TEXT NOT NULL DEFAULT '',
+    imageAttributeName TEXT NOT NULL DEFAULT '',
+    imageAttributeUrl TEXT NOT NULL DEFAULT '',
+    imageWidth INTEGER NOT NULL DEFAULT 0,
+    imageHeight INTEGER NOT NULL DEFAULT 0,
+    imageAlt TEXT NOT NULL DEFAULT '',
+    explainer TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS triviaQuestions_triviaId_idx ON triviaQuestions (triviaId);
+
+CREATE TABLE IF NOT EXISTS triviaAnswers (
+    id SERIAL PRIMARY KEY,
+    triviaQuestionId INTEGER NOT NULL REFERENCES triviaQuestions (id),
+    text TEXT NOT NULL,
+    isCorrect BOOLEAN NOT NULL DEFAULT FALSE,
+    flagCode TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS triviaAnswers_triviaQuestionId_idx ON triviaAnswers (triviaQuestionId);
+
+CREATE TABLE IF NOT EXISTS triviaPlays (
+    id SERIAL PRIMARY KEY,
+    triviaId INTEGER REFERENCES trivia (id),
+    plays INTEGER NOT NULL DEFAULT 0
+);
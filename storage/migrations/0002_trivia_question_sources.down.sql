@@ -0,0 +1,2 @@
+ALTER TABLE triviaQuestions DROP COLUMN IF EXISTS manualQuestionId;
+ALTER TABLE triviaQuestions DROP COLUMN IF EXISTS generator;
@@ -0,0 +1,5 @@
+-- Records where each trivia question came from so that a single question can be
+-- regenerated or pinned.
+
+ALTER TABLE triviaQuestions ADD COLUMN IF NOT EXISTS generator TEXT;
+ALTER TABLE triviaQuestions ADD COLUMN IF NOT EXISTS manualQuestionId INTEGER REFERENCES manualTriviaQuestions (id) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS flagSimilarityGroupEntries;
+DROP TABLE IF EXISTS flagSimilarityGroups;
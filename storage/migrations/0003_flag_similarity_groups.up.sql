@@ -0,0 +1,60 @@
+-- Groups of flags that are easily confused. Flag questions use them to pick wrong
+-- answers that look like the right one.
+
+CREATE TABLE IF NOT EXISTS flagSimilarityGroups (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS flagSimilarityGroupEntries (
+    id SERIAL PRIMARY KEY,
+    groupId INTEGER NOT NULL REFERENCES flagSimilarityGroups (id) ON DELETE CASCADE,
+    code TEXT NOT NULL,
+    UNIQUE (groupId, code)
+);
+
+INSERT INTO flagSimilarityGroups (name) VALUES
+    ('nordic-cross'),
+    ('slavic-tricolour'),
+    ('red-white-bicolour'),
+    ('vertical-tricolour'),
+    ('southern-cross'),
+    ('gran-colombia'),
+    ('arab-tricolour')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO flagSimilarityGroupEntries (groupId, code)
+SELECT g.id, e.code FROM flagSimilarityGroups g JOIN (VALUES
+    ('nordic-cross', 'dk'),
+    ('nordic-cross', 'se'),
+    ('nordic-cross', 'no'),
+    ('nordic-cross', 'fi'),
+    ('nordic-cross', 'is'),
+    ('slavic-tricolour', 'ru'),
+    ('slavic-tricolour', 'si'),
+    ('slavic-tricolour', 'sk'),
+    ('slavic-tricolour', 'rs'),
+    ('slavic-tricolour', 'nl'),
+    ('slavic-tricolour', 'lu'),
+    ('red-white-bicolour', 'id'),
+    ('red-white-bicolour', 'mc'),
+    ('red-white-bicolour', 'pl'),
+    ('vertical-tricolour', 'fr'),
+    ('vertical-tricolour', 'it'),
+    ('vertical-tricolour', 'ie'),
+    ('vertical-tricolour', 'ci'),
+    ('vertical-tricolour', 'td'),
+    ('vertical-tricolour', 'ro'),
+    ('vertical-tricolour', 'ad'),
+    ('southern-cross', 'au'),
+    ('southern-cross', 'nz'),
+    ('gran-colombia', 've'),
+    ('gran-colombia', 'co'),
+    ('gran-colombia', 'ec'),
+    ('arab-tricolour', 'sy'),
+    ('arab-tricolour', 'eg'),
+    ('arab-tricolour', 'iq'),
+    ('arab-tricolour', 'ye'),
+    ('arab-tricolour', 'sd')
+) AS e (name, code) ON e.name = g.name
+ON CONFLICT DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE triviaQuestions DROP COLUMN IF EXISTS distractorStrategy;
@@ -0,0 +1,4 @@
+-- Records how the wrong answers of each generated question were picked so the
+-- strategies can be compared.
+
+ALTER TABLE triviaQuestions ADD COLUMN IF NOT EXISTS distractorStrategy TEXT;
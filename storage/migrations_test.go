@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Errorf("expected migration %d to have version %d; got %d", i, i+1, migration.Version)
+		}
+	}
+
+	if migrations[0].Name != "initial_schema" || migrations[0].Down == "" {
+		t.Errorf("expected a reversible initial_schema migration first; got %s", migrations[0].Name)
+	}
+}
+
+func TestLoadMigrationsValidation(t *testing.T) {
+	tt := []struct {
+		name     string
+		files    fstest.MapFS
+		expected string
+	}{
+		{
+			name:     "missing version",
+			files:    fstest.MapFS{"migrations/schema.up.sql": {Data: []byte("SELECT 1;")}},
+			expected: "migration file schema.up.sql must be named <version>_<name>.up.sql",
+		},
+		{
+			name: "duplicate version",
+			files: fstest.MapFS{
+				"migrations/0001_first.up.sql":  {Data: []byte("SELECT 1;")},
+				"migrations/0001_second.up.sql": {Data: []byte("SELECT 1;")},
+			},
+			expected: "migration version 1 is used by both first and second",
+		},
+		{
+			name:     "down without up",
+			files:    fstest.MapFS{"migrations/0001_first.down.sql": {Data: []byte("SELECT 1;")}},
+			expected: "migration 1_first has no up file",
+		},
+		{
+			name: "happy path",
+			files: fstest.MapFS{
+				"migrations/0002_second.up.sql":  {Data: []byte("SELECT 2;")},
+				"migrations/0001_first.up.sql":   {Data: []byte("SELECT 1;")},
+				"migrations/0001_first.down.sql": {Data: []byte("SELECT 0;")},
+				"migrations/README.md":           {Data: []byte("ignored")},
+			},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			migrations, err := loadMigrations(tc.files, "migrations")
+			if tc.expected == "" && err != nil {
+				t.Fatalf("expected no error; got %v", err)
+			}
+
+			if tc.expected != "" && (err == nil || err.Error() != tc.expected) {
+				t.Fatalf("expected error %q; got %v", tc.expected, err)
+			}
+
+			if tc.expected == "" && (len(migrations) != 2 || migrations[0].Down != "SELECT 0;" || migrations[1].Name != "second") {
+				t.Errorf("unexpected migrations %+v", migrations)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"math/rand"
 	"strconv"
 	"time"
@@ -11,8 +13,29 @@ import (
 	_ "github.com/lib/pq"
 )
 
+// scanner is satisfied by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+const manualTriviaQuestionColumns = "id, typeId, categoryId, question, map, highlighted, flagCode, imageUrl, imageAttributeName, imageAttributeUrl, imageWidth, imageHeight, imageAlt, explainer, lastUsed, quizDate, lastUpdated"
+
+func scanManualTriviaQuestion(row scanner) (types.ManualTriviaQuestion, error) {
+	var question types.ManualTriviaQuestion
+	err := row.Scan(&question.ID, &question.TypeID, &question.CategoryID, &question.Question, &question.Map, &question.Highlighted, &question.FlagCode, &question.ImageURL, &question.ImageAttributeName, &question.ImageAttributeURL, &question.ImageWidth, &question.ImageHeight, &question.ImageAlt, &question.Explainer, &question.LastUsed, &question.QuizDate, &question.LastUpdated)
+	return question, err
+}
+
+// queryer is satisfied by both *sql.DB and *sql.Tx so that the same queries can
+// run inside or outside of a transaction.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
 type PostgresStore struct {
-	connection *sql.DB
+	db         *sql.DB
+	connection database
 }
 
 func NewPostgresStore(connectionString string) (*PostgresStore, error) {
@@ -21,66 +44,116 @@ func NewPostgresStore(connectionString string) (*PostgresStore, error) {
 		return nil, err
 	}
 
-	return &PostgresStore{connection}, err
+	return &PostgresStore{connection, database{connection}}, err
 }
 
-func (s *PostgresStore) ClearTriviaPlayTriviaId(triviaId int) error {
+// WithTransaction runs fn against a store scoped to a single transaction. The
+// transaction is committed if fn succeeds and rolled back otherwise, including when
+// ctx is cancelled. Calling it on a store that is already scoped to a transaction
+// reuses that transaction.
+func (s *PostgresStore) WithTransaction(ctx context.Context, fn func(store IStore) error) error {
+	if s.db == nil {
+		return fn(s)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return translateError(err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(&PostgresStore{connection: database{tx}}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return translateError(tx.Commit())
+}
+
+// triviaDateLockNamespace is the first key of the advisory locks taken on trivia
+// dates so they cannot collide with advisory locks used for anything else.
+const triviaDateLockNamespace = 1953655158
+
+// LockTriviaDate attempts to take a transaction scoped advisory lock on the date.
+// It returns false without waiting if another transaction already holds the lock.
+func (s *PostgresStore) LockTriviaDate(ctx context.Context, date time.Time) (bool, error) {
+	if s.db != nil {
+		return false, errors.New("trivia date locks can only be taken inside a transaction")
+	}
+
+	var locked bool
+	err := s.connection.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock($1, $2);", triviaDateLockNamespace, dateLockKey(date)).Scan(&locked)
+	return locked, err
+}
+
+func dateLockKey(date time.Time) int {
+	year, month, day := date.Date()
+	return year*10000 + int(month)*100 + day
+}
+
+func (s *PostgresStore) ClearTriviaPlayTriviaId(ctx context.Context, triviaId int) error {
 	var id int
 	statement := "UPDATE triviaplays set triviaid = null WHERE triviaid = $1 RETURNING id;"
-	return s.connection.QueryRow(statement, triviaId).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, triviaId).Scan(&id)
 }
 
-func (s *PostgresStore) DeleteTriviaAnswers(triviaQuestionId int) error {
+func (s *PostgresStore) DeleteTriviaAnswers(ctx context.Context, triviaQuestionId int) error {
 	statement := "DELETE FROM triviaAnswers WHERE triviaQuestionId = $1 RETURNING id;"
 	var id int
-	return s.connection.QueryRow(statement, triviaQuestionId).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, triviaQuestionId).Scan(&id)
 }
 
-func (s *PostgresStore) DeleteTrivia(trivia *types.TriviaDto) error {
-	if err := s.clearTriviaPlayTriviaId(trivia.ID); err != nil && err != sql.ErrNoRows {
+func (s *PostgresStore) DeleteTrivia(ctx context.Context, trivia *types.TriviaDto) error {
+	if err := s.clearTriviaPlayTriviaId(ctx, trivia.ID); err != nil && err != sql.ErrNoRows {
 		return err
 	}
 
 	for _, question := range trivia.Questions {
-		if err := s.deleteTriviaAnswers(question.ID); err != nil && err != sql.ErrNoRows {
+		if err := s.deleteTriviaAnswers(ctx, question.ID); err != nil && err != sql.ErrNoRows {
 			return err
 		}
 
-		if err := s.deleteTriviaQuestion(question.ID); err != nil && err != sql.ErrNoRows {
+		if err := s.DeleteTriviaQuestion(ctx, question.ID); err != nil && err != sql.ErrNoRows {
 			return err
 		}
 	}
 
 	var id int
-	return s.connection.QueryRow("DELETE FROM trivia WHERE id = $1 RETURNING id;", trivia.ID).Scan(&id)
+	return s.connection.QueryRowContext(ctx, "DELETE FROM trivia WHERE id = $1 RETURNING id;", trivia.ID).Scan(&id)
 }
 
-func (s *PostgresStore) clearTriviaPlayTriviaId(triviaId int) error {
+func (s *PostgresStore) clearTriviaPlayTriviaId(ctx context.Context, triviaId int) error {
 	var id int
 	statement := "UPDATE triviaplays set triviaid = null WHERE triviaid = $1 RETURNING id;"
-	return s.connection.QueryRow(statement, triviaId).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, triviaId).Scan(&id)
 }
 
-func (s *PostgresStore) deleteTriviaAnswers(triviaQuestionId int) error {
+func (s *PostgresStore) deleteTriviaAnswers(ctx context.Context, triviaQuestionId int) error {
 	statement := "DELETE FROM triviaAnswers WHERE triviaQuestionId = $1 RETURNING id;"
 	var id int
-	return s.connection.QueryRow(statement, triviaQuestionId).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, triviaQuestionId).Scan(&id)
 }
 
-func (s *PostgresStore) deleteTriviaQuestion(questionId int) error {
+func (s *PostgresStore) DeleteTriviaQuestion(ctx context.Context, questionId int) error {
 	statement := "DELETE FROM triviaQuestions WHERE id = $1 RETURNING id;"
 	var id int
-	return s.connection.QueryRow(statement, questionId).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, questionId).Scan(&id)
 }
 
-func (s *PostgresStore) GetTrivia(date string) (*types.TriviaDto, error) {
+func (s *PostgresStore) GetTrivia(ctx context.Context, date string) (*types.TriviaDto, error) {
 	var result types.TriviaDto
-	err := s.connection.QueryRow("SELECT id, name, maxscore from trivia WHERE date = $1;", date).Scan(&result.ID, &result.Name, &result.MaxScore)
+	err := s.connection.QueryRowContext(ctx, "SELECT id, name, date, maxscore from trivia WHERE date = $1;", date).Scan(&result.ID, &result.Name, &result.Date, &result.MaxScore)
 	if err != nil {
 		return nil, err
 	}
 
-	questions, err := s.getTriviaQuestions(result.ID)
+	questions, err := s.getTriviaQuestions(ctx, result.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -89,35 +162,67 @@ func (s *PostgresStore) GetTrivia(date string) (*types.TriviaDto, error) {
 	return &result, nil
 }
 
-func (s *PostgresStore) getTriviaQuestions(triviaId int) ([]types.QuestionDto, error) {
-	rows, err := s.connection.Query("SELECT q.id, t.name, q.question, q.map, q.highlighted, q.flagCode, f.url, q.imageUrl, q.imageAttributeName, q.imageAttributeUrl, q.imageWidth, q.imageHeight, q.imageAlt, q.explainer FROM triviaQuestions q JOIN triviaQuestionType t ON t.id = q.typeId LEFT JOIN flagEntries f ON f.code = q.flagCode WHERE q.triviaId = $1;", triviaId)
+// GetAllTrivia returns a page of trivia ordered by date without their questions.
+// One more row than the limit is requested so callers can tell if there are more.
+func (s *PostgresStore) GetAllTrivia(ctx context.Context, filter types.GetTriviaFilter) ([]types.TriviaDto, error) {
+	statement := "SELECT id, name, date, maxscore FROM trivia WHERE ($1 = '' OR date >= NULLIF($1, '')::date) AND ($2 = '' OR date <= NULLIF($2, '')::date) ORDER BY date DESC LIMIT $3 OFFSET $4;"
+	rows, err := s.connection.QueryContext(ctx, statement, filter.From, filter.To, filter.Limit+1, filter.Page*filter.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var questions = []types.QuestionDto{}
+	var trivia = []types.TriviaDto{}
 	for rows.Next() {
-		var question types.QuestionDto
-		if err = rows.Scan(&question.ID, &question.Type, &question.Question, &question.MapName, &question.Highlighted, &question.FlagCode, &question.FlagUrl, &question.ImageURL, &question.ImageAttributeName, &question.ImageAttributeURL, &question.ImageWidth, &question.ImageHeight, &question.ImageAlt, &question.Explainer); err != nil {
+		var t types.TriviaDto
+		if err = rows.Scan(&t.ID, &t.Name, &t.Date, &t.MaxScore); err != nil {
 			return nil, err
 		}
+		trivia = append(trivia, t)
+	}
+	return trivia, rows.Err()
+}
 
-		if question.MapName != "" {
-			svgMap, err := s.GetMap(question.MapName)
-			if err != nil {
-				return nil, err
-			}
-			question.Map = svgMap
-		}
+// getTriviaQuestions loads the questions of a trivia along with their answers,
+// which are loaded with a single query. Questions only carry the class name of
+// their map so that callers can read maps through GetMap.
+func (s *PostgresStore) getTriviaQuestions(ctx context.Context, triviaId int) ([]types.QuestionDto, error) {
+	rows, err := s.connection.QueryContext(ctx, "SELECT q.id, t.name, q.question, q.map, q.highlighted, q.flagCode, f.url, q.imageUrl, q.imageAttributeName, q.imageAttributeUrl, q.imageWidth, q.imageHeight, q.imageAlt, q.explainer FROM triviaQuestions q JOIN triviaQuestionType t ON t.id = q.typeId LEFT JOIN flagEntries f ON f.code = q.flagCode WHERE q.triviaId = $1;", triviaId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-		answers, err := s.getTriviaAnswers(question.ID)
-		if err != nil {
+	var questions = []types.QuestionDto{}
+	var questionIds []int64
+	for rows.Next() {
+		var question types.QuestionDto
+		if err = rows.Scan(&question.ID, &question.Type, &question.Question, &question.MapName, &question.Highlighted, &question.FlagCode, &question.FlagUrl, &question.ImageURL, &question.ImageAttributeName, &question.ImageAttributeURL, &question.ImageWidth, &question.ImageHeight, &question.ImageAlt, &question.Explainer); err != nil {
 			return nil, err
 		}
 
-		question.Answers = answers
 		questions = append(questions, question)
+		questionIds = append(questionIds, int64(question.ID))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(questions) == 0 {
+		return questions, nil
+	}
+
+	answers, err := s.getTriviaAnswers(ctx, questionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, question := range questions {
+		questions[i].Answers = answers[question.ID]
+		if questions[i].Answers == nil {
+			questions[i].Answers = []types.AnswerDto{}
+		}
 	}
 
 	rand.Shuffle(len(questions), func(i, j int) {
@@ -127,75 +232,123 @@ func (s *PostgresStore) getTriviaQuestions(triviaId int) ([]types.QuestionDto, e
 	return questions, nil
 }
 
-func (s *PostgresStore) getTriviaAnswers(triviaQuestionId int) ([]types.AnswerDto, error) {
-	rows, err := s.connection.Query("SELECT a.text, a.isCorrect, a.flagCode, f.url FROM triviaAnswers a LEFT JOIN flagentries f ON f.code = a.flagcode WHERE triviaQuestionId = $1;", triviaQuestionId)
+// getTriviaAnswers returns the answers for the questions keyed by question id.
+func (s *PostgresStore) getTriviaAnswers(ctx context.Context, triviaQuestionIds []int64) (map[int][]types.AnswerDto, error) {
+	rows, err := s.connection.QueryContext(ctx, "SELECT a.triviaQuestionId, a.text, a.isCorrect, a.flagCode, f.url FROM triviaAnswers a LEFT JOIN flagentries f ON f.code = a.flagcode WHERE a.triviaQuestionId = ANY($1);", pq.Array(triviaQuestionIds))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var answers = []types.AnswerDto{}
+	answers := make(map[int][]types.AnswerDto)
 	for rows.Next() {
+		var questionId int
 		var answer types.AnswerDto
-		if err = rows.Scan(&answer.Text, &answer.IsCorrect, &answer.FlagCode, &answer.FlagUrl); err != nil {
+		if err = rows.Scan(&questionId, &answer.Text, &answer.IsCorrect, &answer.FlagCode, &answer.FlagUrl); err != nil {
 			return nil, err
 		}
-		answers = append(answers, answer)
+		answers[questionId] = append(answers[questionId], answer)
 	}
 
-	if len(answers) > 2 {
-		rand.Shuffle(len(answers), func(i, j int) {
-			answers[i], answers[j] = answers[j], answers[i]
-		})
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, val := range answers {
+		if len(val) > 2 {
+			rand.Shuffle(len(val), func(i, j int) {
+				val[i], val[j] = val[j], val[i]
+			})
+		}
 	}
 
 	return answers, nil
 }
 
-func (s *PostgresStore) GetMap(className string) (types.MapDto, error) {
-	statement := "SELECT * from maps WHERE classname = $1;"
-	var m types.MapDto
-	err := s.connection.QueryRow(statement, className).Scan(&m.ID, &m.Key, &m.ClassName, &m.Label, &m.ViewBox)
+func (s *PostgresStore) GetMap(ctx context.Context, className string) (types.MapDto, error) {
+	maps, err := s.getMaps(ctx, []string{className})
 	if err != nil {
 		return types.MapDto{}, err
 	}
 
-	elements, err := s.getMapElements(m.ID)
+	m, ok := maps[className]
+	if !ok {
+		return types.MapDto{}, sql.ErrNoRows
+	}
+	return m, nil
+}
+
+// getMaps returns the maps with the given class names and their elements keyed
+// by class name. Class names without a map are left out of the result.
+func (s *PostgresStore) getMaps(ctx context.Context, classNames []string) (map[string]types.MapDto, error) {
+	maps := make(map[string]types.MapDto)
+	if len(classNames) == 0 {
+		return maps, nil
+	}
+
+	rows, err := s.connection.QueryContext(ctx, "SELECT id, key, className, label, viewBox FROM maps WHERE className = ANY($1);", pq.Array(classNames))
 	if err != nil {
-		return types.MapDto{}, err
+		return nil, err
 	}
+	defer rows.Close()
 
-	m.Elements = elements
-	return m, nil
+	var mapIds []int64
+	for rows.Next() {
+		var m types.MapDto
+		if err = rows.Scan(&m.ID, &m.Key, &m.ClassName, &m.Label, &m.ViewBox); err != nil {
+			return nil, err
+		}
+		maps[m.ClassName] = m
+		mapIds = append(mapIds, int64(m.ID))
+	}
+
+	if err = rows.Err(); err != nil || len(mapIds) == 0 {
+		return maps, err
+	}
+
+	elements, err := s.getMapElements(ctx, mapIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for className, m := range maps {
+		m.Elements = elements[m.ID]
+		if m.Elements == nil {
+			m.Elements = []types.MapElementDto{}
+		}
+		maps[className] = m
+	}
+	return maps, nil
 }
 
-func (s *PostgresStore) getMapElements(mapId int) ([]types.MapElementDto, error) {
-	rows, err := s.connection.Query("SELECT e.id, e.mapid, t.name, e.elementid, e.name, e.d, e.points, e.x, e.y, e.width, e.height, e.cx, e.cy, e.r, e.transform, e.xlinkhref, e.clippath, e.clippathid, e.x1, e.y1, e.x2, e.y2 FROM mapElements e JOIN mapElementType t ON t.id = e.typeid WHERE e.mapId = $1;", mapId)
+// getMapElements returns the elements of the maps keyed by map id.
+func (s *PostgresStore) getMapElements(ctx context.Context, mapIds []int64) (map[int][]types.MapElementDto, error) {
+	rows, err := s.connection.QueryContext(ctx, "SELECT e.id, e.mapid, t.name, e.elementid, e.name, e.d, e.points, e.x, e.y, e.width, e.height, e.cx, e.cy, e.r, e.transform, e.xlinkhref, e.clippath, e.clippathid, e.x1, e.y1, e.x2, e.y2 FROM mapElements e JOIN mapElementType t ON t.id = e.typeid WHERE e.mapId = ANY($1);", pq.Array(mapIds))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var elements = []types.MapElementDto{}
+	elements := make(map[int][]types.MapElementDto)
 	for rows.Next() {
 		var e types.MapElementDto
 		if err = rows.Scan(&e.EntryID, &e.MapID, &e.Type, &e.ID, &e.Name, &e.D, &e.Points, &e.X, &e.Y, &e.Width, &e.Height, &e.Cx, &e.Cy, &e.R, &e.Transform, &e.XlinkHref, &e.ClipPath, &e.ClipPathId, &e.X1, &e.Y1, &e.X2, &e.Y2); err != nil {
 			return nil, err
 		}
-		elements = append(elements, e)
+		elements[e.MapID] = append(elements[e.MapID], e)
 	}
 
 	return elements, rows.Err()
 }
 
-func (s *PostgresStore) SetTriviaMaxScore(triviaID, maxScore int) error {
+func (s *PostgresStore) SetTriviaMaxScore(ctx context.Context, triviaID, maxScore int) error {
 	statement := "UPDATE trivia SET maxScore = $1 WHERE id = $2 RETURNING id;"
 	var id int
-	return s.connection.QueryRow(statement, maxScore, triviaID).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, maxScore, triviaID).Scan(&id)
 }
 
-func (s *PostgresStore) GetMappingEntries(key string) ([]types.MappingEntryDto, error) {
-	rows, err := s.connection.Query("SELECT m.id, m.groupid, m.name, m.code, COALESCE(f.url, ''), m.svgname, lower(m.alternativenames::text)::text[], lower(m.prefixes::text)::text[], m.grouping from mappingEntries m JOIN mappingGroups g ON g.id = m.groupId LEFT JOIN flagEntries f ON f.code = m.code WHERE g.key = $1;", key)
+func (s *PostgresStore) GetMappingEntries(ctx context.Context, key string) ([]types.MappingEntryDto, error) {
+	rows, err := s.connection.QueryContext(ctx, "SELECT m.id, m.groupid, m.name, m.code, COALESCE(f.url, ''), m.svgname, lower(m.alternativenames::text)::text[], lower(m.prefixes::text)::text[], m.grouping from mappingEntries m JOIN mappingGroups g ON g.id = m.groupId LEFT JOIN flagEntries f ON f.code = m.code WHERE g.key = $1;", key)
 	if err != nil {
 		return nil, err
 	}
@@ -212,9 +365,30 @@ func (s *PostgresStore) GetMappingEntries(key string) ([]types.MappingEntryDto,
 	return entries, rows.Err()
 }
 
-func (s *PostgresStore) GetTodaysManualTriviaQuestions() ([]types.ManualTriviaQuestion, error) {
-	today := time.Now().Format("2006-01-02")
-	rows, err := s.connection.Query("SELECT * FROM manualtriviaquestions WHERE quizDate = $1;", today)
+// GetFlagSimilarityGroups returns every flag similarity group with the codes of
+// its flags.
+func (s *PostgresStore) GetFlagSimilarityGroups(ctx context.Context) ([]types.FlagSimilarityGroup, error) {
+	rows, err := s.connection.QueryContext(ctx, "SELECT g.id, g.name, array_agg(e.code ORDER BY e.code) FROM flagSimilarityGroups g JOIN flagSimilarityGroupEntries e ON e.groupId = g.id GROUP BY g.id, g.name ORDER BY g.id;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups = []types.FlagSimilarityGroup{}
+	for rows.Next() {
+		var group types.FlagSimilarityGroup
+		if err = rows.Scan(&group.ID, &group.Name, pq.Array(&group.Codes)); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+// GetManualTriviaQuestionsForDate returns the manual questions scheduled for the
+// quiz on the given date.
+func (s *PostgresStore) GetManualTriviaQuestionsForDate(ctx context.Context, quizDate string) ([]types.ManualTriviaQuestion, error) {
+	rows, err := s.connection.QueryContext(ctx, "SELECT "+manualTriviaQuestionColumns+" FROM manualtriviaquestions WHERE quizDate = $1;", quizDate)
 	if err != nil {
 		return nil, err
 	}
@@ -222,8 +396,8 @@ func (s *PostgresStore) GetTodaysManualTriviaQuestions() ([]types.ManualTriviaQu
 
 	var questions = []types.ManualTriviaQuestion{}
 	for rows.Next() {
-		var question types.ManualTriviaQuestion
-		if err = rows.Scan(&question.ID, &question.TypeID, &question.Question, &question.Map, &question.Highlighted, &question.FlagCode, &question.ImageURL, &question.LastUsed, &question.QuizDate, &question.Explainer, &question.LastUpdated, &question.CategoryID, &question.ImageAttributeName, &question.ImageAttributeURL, &question.ImageWidth, &question.ImageHeight, &question.ImageAlt); err != nil {
+		question, err := scanManualTriviaQuestion(rows)
+		if err != nil {
 			return nil, err
 		}
 		questions = append(questions, question)
@@ -231,14 +405,14 @@ func (s *PostgresStore) GetTodaysManualTriviaQuestions() ([]types.ManualTriviaQu
 	return questions, rows.Err()
 }
 
-func (s *PostgresStore) GetTriviaQuestionCategories(onlyActive bool) ([]types.TriviaQuestionCategory, error) {
-	statement := "SELECT * from triviaquestioncategory"
+func (s *PostgresStore) GetTriviaQuestionCategories(ctx context.Context, onlyActive bool) ([]types.TriviaQuestionCategory, error) {
+	statement := "SELECT id, name, isActive, imageOnly FROM triviaquestioncategory"
 	if onlyActive {
 		statement += " WHERE isactive"
 	}
 	statement += ";"
 
-	rows, err := s.connection.Query(statement)
+	rows, err := s.connection.QueryContext(ctx, statement)
 	if err != nil {
 		return nil, err
 	}
@@ -255,17 +429,24 @@ func (s *PostgresStore) GetTriviaQuestionCategories(onlyActive bool) ([]types.Tr
 	return categories, rows.Err()
 }
 
-func (s *PostgresStore) CreateTriviaQuestion(question types.TriviaQuestion) (int, error) {
-	statement := "INSERT INTO triviaQuestions (triviaId, typeId, question, map, highlighted, flagCode, imageUrl, imageAttributeName, imageAttributeUrl, imageWidth, imageHeight, imageAlt, explainer) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id;"
+func (s *PostgresStore) GetTriviaQuestion(ctx context.Context, questionID int) (types.TriviaQuestion, error) {
+	statement := "SELECT id, triviaId, typeId, question, map, highlighted, flagCode, imageUrl, imageAttributeName, imageAttributeUrl, imageWidth, imageHeight, imageAlt, explainer, COALESCE(generator, ''), COALESCE(manualQuestionId, 0), COALESCE(distractorStrategy, '') FROM triviaQuestions WHERE id = $1;"
+	var q types.TriviaQuestion
+	err := s.connection.QueryRowContext(ctx, statement, questionID).Scan(&q.ID, &q.TriviaId, &q.TypeID, &q.Question, &q.Map, &q.Highlighted, &q.FlagCode, &q.ImageURL, &q.ImageAttributeName, &q.ImageAttributeURL, &q.ImageWidth, &q.ImageHeight, &q.ImageAlt, &q.Explainer, &q.Generator, &q.ManualQuestionID, &q.DistractorStrategy)
+	return q, err
+}
+
+func (s *PostgresStore) CreateTriviaQuestion(ctx context.Context, question types.TriviaQuestion) (int, error) {
+	statement := "INSERT INTO triviaQuestions (triviaId, typeId, question, map, highlighted, flagCode, imageUrl, imageAttributeName, imageAttributeUrl, imageWidth, imageHeight, imageAlt, explainer, generator, manualQuestionId, distractorStrategy) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULLIF($14, ''), NULLIF($15, 0), NULLIF($16, '')) RETURNING id;"
 	var id int
-	err := s.connection.QueryRow(statement, question.TriviaId, question.TypeID, question.Question, question.Map, question.Highlighted, question.FlagCode, question.ImageURL, question.ImageAttributeName, question.ImageAttributeURL, question.ImageWidth, question.ImageHeight, question.ImageAlt, question.Explainer).Scan(&id)
+	err := s.connection.QueryRowContext(ctx, statement, question.TriviaId, question.TypeID, question.Question, question.Map, question.Highlighted, question.FlagCode, question.ImageURL, question.ImageAttributeName, question.ImageAttributeURL, question.ImageWidth, question.ImageHeight, question.ImageAlt, question.Explainer, question.Generator, question.ManualQuestionID, question.DistractorStrategy).Scan(&id)
 	return id, err
 }
 
-func (s *PostgresStore) CreateTriviaAnswer(answer types.TriviaAnswer) error {
+func (s *PostgresStore) CreateTriviaAnswer(ctx context.Context, answer types.TriviaAnswer) error {
 	statement := "INSERT INTO triviaAnswers (triviaQuestionId, text, isCorrect, flagCode) VALUES ($1, $2, $3, $4) RETURNING id;"
 	var id int
-	return s.connection.QueryRow(statement, answer.TriviaQuestionID, answer.Text, answer.IsCorrect, answer.FlagCode).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, answer.TriviaQuestionID, answer.Text, answer.IsCorrect, answer.FlagCode).Scan(&id)
 }
 
 func convertCategories(categories []int) []string {
@@ -276,9 +457,9 @@ func convertCategories(categories []int) []string {
 	return result
 }
 
-func (s *PostgresStore) GetManualTriviaQuestions(typeID int, lastUsedMax string, allowedCategories []int) ([]types.ManualTriviaQuestion, error) {
-	statement := "SELECT DISTINCT ON (categoryid) * FROM manualtriviaquestions WHERE typeid = $1 AND quizdate IS null AND (lastUsed IS null OR lastUsed < $2) AND categoryid = ANY($3);"
-	rows, err := s.connection.Query(statement, typeID, lastUsedMax, pq.Array(convertCategories(allowedCategories)))
+func (s *PostgresStore) GetManualTriviaQuestions(ctx context.Context, typeID int, lastUsedMax string, allowedCategories []int) ([]types.ManualTriviaQuestion, error) {
+	statement := "SELECT DISTINCT ON (categoryid) " + manualTriviaQuestionColumns + " FROM manualtriviaquestions WHERE typeid = $1 AND quizdate IS null AND (lastUsed IS null OR lastUsed < $2) AND categoryid = ANY($3);"
+	rows, err := s.connection.QueryContext(ctx, statement, typeID, lastUsedMax, pq.Array(convertCategories(allowedCategories)))
 	if err != nil {
 		return nil, err
 	}
@@ -286,8 +467,8 @@ func (s *PostgresStore) GetManualTriviaQuestions(typeID int, lastUsedMax string,
 
 	var questions = []types.ManualTriviaQuestion{}
 	for rows.Next() {
-		var question types.ManualTriviaQuestion
-		if err = rows.Scan(&question.ID, &question.TypeID, &question.Question, &question.Map, &question.Highlighted, &question.FlagCode, &question.ImageURL, &question.LastUsed, &question.QuizDate, &question.Explainer, &question.LastUpdated, &question.CategoryID, &question.ImageAttributeName, &question.ImageAttributeURL, &question.ImageWidth, &question.ImageHeight, &question.ImageAlt); err != nil {
+		question, err := scanManualTriviaQuestion(rows)
+		if err != nil {
 			return nil, err
 		}
 		questions = append(questions, question)
@@ -295,8 +476,12 @@ func (s *PostgresStore) GetManualTriviaQuestions(typeID int, lastUsedMax string,
 	return questions, rows.Err()
 }
 
-func (s *PostgresStore) GetManualTriviaAnswers(questionID int) ([]types.ManualTriviaAnswer, error) {
-	rows, err := s.connection.Query("SELECT * FROM manualtriviaanswers WHERE manualtriviaquestionid = $1;", questionID)
+func (s *PostgresStore) GetManualTriviaQuestion(ctx context.Context, questionID int) (types.ManualTriviaQuestion, error) {
+	return scanManualTriviaQuestion(s.connection.QueryRowContext(ctx, "SELECT "+manualTriviaQuestionColumns+" FROM manualtriviaquestions WHERE id = $1;", questionID))
+}
+
+func (s *PostgresStore) GetManualTriviaAnswers(ctx context.Context, questionID int) ([]types.ManualTriviaAnswer, error) {
+	rows, err := s.connection.QueryContext(ctx, "SELECT id, manualTriviaQuestionId, text, isCorrect, flagCode FROM manualtriviaanswers WHERE manualtriviaquestionid = $1;", questionID)
 	if err != nil {
 		return nil, err
 	}
@@ -314,22 +499,23 @@ func (s *PostgresStore) GetManualTriviaAnswers(questionID int) ([]types.ManualTr
 	return answers, rows.Err()
 }
 
-func (s *PostgresStore) UpdateManualTriviaQuestionLastUsed(questionID int) error {
-	today := time.Now().Format("2006-01-02")
-	statement := "UPDATE manualtriviaquestions SET lastUsed = $2 WHERE id = $1 RETURNING id;"
+// UpdateManualTriviaQuestionLastUsed records the date of the quiz the manual
+// question was last used in, keeping a later date if one is already recorded.
+func (s *PostgresStore) UpdateManualTriviaQuestionLastUsed(ctx context.Context, questionID int, quizDate string) error {
+	statement := "UPDATE manualtriviaquestions SET lastUsed = GREATEST(lastUsed, $2::date) WHERE id = $1 RETURNING id;"
 	var id int
-	return s.connection.QueryRow(statement, questionID, today).Scan(&id)
+	return s.connection.QueryRowContext(ctx, statement, questionID, quizDate).Scan(&id)
 }
 
-func (s *PostgresStore) TriviaDoesNotExistForDate(date time.Time) (bool, error) {
+func (s *PostgresStore) TriviaDoesNotExistForDate(ctx context.Context, date time.Time) (bool, error) {
 	var id int
-	err := s.connection.QueryRow("SELECT id FROM trivia WHERE date = $1", date).Scan(&id)
+	err := s.connection.QueryRowContext(ctx, "SELECT id FROM trivia WHERE date = $1", date).Scan(&id)
 	return err == sql.ErrNoRows, err
 }
 
-func (s *PostgresStore) CreateTrivia(name string, date time.Time) (int, error) {
+func (s *PostgresStore) CreateTrivia(ctx context.Context, name string, date time.Time) (int, error) {
 	var id int
 	statement := "INSERT INTO trivia (name, date, maxscore) VALUES ($1, $2, $3) RETURNING id;"
-	err := s.connection.QueryRow(statement, name, date, 0).Scan(&id)
+	err := s.connection.QueryRowContext(ctx, statement, name, date, 0).Scan(&id)
 	return id, err
 }
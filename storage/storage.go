@@ -1,26 +1,34 @@
 package storage
 
 import (
+	"context"
 	"time"
 
 	"github.com/geobuff/generate/types"
 )
 
 type IStore interface {
-	ClearTriviaPlayTriviaId(triviaId int) error
-	DeleteTriviaAnswers(triviaQuestionId int) error
-	GetTrivia(date string) (*types.TriviaDto, error)
-	DeleteTrivia(trivia *types.TriviaDto) error
-	SetTriviaMaxScore(triviaID, maxScore int) error
-	GetMappingEntries(key string) ([]types.MappingEntryDto, error)
-	GetTodaysManualTriviaQuestions() ([]types.ManualTriviaQuestion, error)
-	GetTriviaQuestionCategories(onlyActive bool) ([]types.TriviaQuestionCategory, error)
-	GetMap(className string) (types.MapDto, error)
-	CreateTriviaQuestion(question types.TriviaQuestion) (int, error)
-	CreateTriviaAnswer(answer types.TriviaAnswer) error
-	GetManualTriviaQuestions(typeID int, lastUsedMax string, allowedCategories []int) ([]types.ManualTriviaQuestion, error)
-	GetManualTriviaAnswers(questionID int) ([]types.ManualTriviaAnswer, error)
-	UpdateManualTriviaQuestionLastUsed(questionID int) error
-	TriviaDoesNotExistForDate(date time.Time) (bool, error)
-	CreateTrivia(name string, date time.Time) (int, error)
+	WithTransaction(ctx context.Context, fn func(store IStore) error) error
+	LockTriviaDate(ctx context.Context, date time.Time) (bool, error)
+	ClearTriviaPlayTriviaId(ctx context.Context, triviaId int) error
+	DeleteTriviaAnswers(ctx context.Context, triviaQuestionId int) error
+	DeleteTriviaQuestion(ctx context.Context, questionId int) error
+	GetTrivia(ctx context.Context, date string) (*types.TriviaDto, error)
+	GetAllTrivia(ctx context.Context, filter types.GetTriviaFilter) ([]types.TriviaDto, error)
+	DeleteTrivia(ctx context.Context, trivia *types.TriviaDto) error
+	SetTriviaMaxScore(ctx context.Context, triviaID, maxScore int) error
+	GetMappingEntries(ctx context.Context, key string) ([]types.MappingEntryDto, error)
+	GetManualTriviaQuestionsForDate(ctx context.Context, quizDate string) ([]types.ManualTriviaQuestion, error)
+	GetTriviaQuestionCategories(ctx context.Context, onlyActive bool) ([]types.TriviaQuestionCategory, error)
+	GetMap(ctx context.Context, className string) (types.MapDto, error)
+	GetFlagSimilarityGroups(ctx context.Context) ([]types.FlagSimilarityGroup, error)
+	GetTriviaQuestion(ctx context.Context, questionID int) (types.TriviaQuestion, error)
+	CreateTriviaQuestion(ctx context.Context, question types.TriviaQuestion) (int, error)
+	CreateTriviaAnswer(ctx context.Context, answer types.TriviaAnswer) error
+	GetManualTriviaQuestions(ctx context.Context, typeID int, lastUsedMax string, allowedCategories []int) ([]types.ManualTriviaQuestion, error)
+	GetManualTriviaQuestion(ctx context.Context, questionID int) (types.ManualTriviaQuestion, error)
+	GetManualTriviaAnswers(ctx context.Context, questionID int) ([]types.ManualTriviaAnswer, error)
+	UpdateManualTriviaQuestionLastUsed(ctx context.Context, questionID int, quizDate string) error
+	TriviaDoesNotExistForDate(ctx context.Context, date time.Time) (bool, error)
+	CreateTrivia(ctx context.Context, name string, date time.Time) (int, error)
 }
@@ -14,13 +14,70 @@ const (
 	QUESTION_TYPE_MAP
 )
 
+const (
+	MANUAL_SLOT_SCHEDULED = "scheduled"
+	MANUAL_SLOT_TEXT      = "text"
+	MANUAL_SLOT_IMAGE     = "image"
+)
+
+const (
+	TRIVIA_RANGE_CREATED     = "created"
+	TRIVIA_RANGE_REGENERATED = "regenerated"
+	TRIVIA_RANGE_SKIPPED     = "skipped"
+	TRIVIA_RANGE_FAILED      = "failed"
+)
+
+const (
+	DISTRACTOR_STRATEGY_RANDOM       = "random"
+	DISTRACTOR_STRATEGY_GROUPING     = "grouping"
+	DISTRACTOR_STRATEGY_NEARBY       = "nearby"
+	DISTRACTOR_STRATEGY_SIMILAR_NAME = "similar-name"
+	DISTRACTOR_STRATEGY_SIMILAR_FLAG = "similar-flag"
+)
+
 type TriviaDto struct {
 	ID        int           `json:"id"`
 	Name      string        `json:"name"`
+	Date      time.Time     `json:"date"`
 	MaxScore  int           `json:"maxScore"`
 	Questions []QuestionDto `json:"questions"`
 }
 
+type GetTriviaFilter struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Page  int    `json:"page"`
+	Limit int    `json:"limit"`
+}
+
+type TriviaPageDto struct {
+	Trivia  []TriviaDto `json:"trivia"`
+	HasMore bool        `json:"hasMore"`
+}
+
+type TriviaRangeResult struct {
+	Date   string `json:"date"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type SchedulerStatus struct {
+	Running         bool               `json:"running"`
+	LookaheadDays   int                `json:"lookaheadDays"`
+	LastRunStarted  *time.Time         `json:"lastRunStarted"`
+	LastRunFinished *time.Time         `json:"lastRunFinished"`
+	NextRun         *time.Time         `json:"nextRun"`
+	MissingDates    []string           `json:"missingDates"`
+	GeneratedDates  []string           `json:"generatedDates"`
+	Failures        []SchedulerFailure `json:"failures"`
+}
+
+type SchedulerFailure struct {
+	Date     string `json:"date"`
+	Attempts int    `json:"attempts"`
+	Error    string `json:"error"`
+}
+
 type QuestionDto struct {
 	ID                 int            `json:"id"`
 	Type               string         `json:"type"`
@@ -81,6 +138,22 @@ type MapElementDto struct {
 	Y2         string `json:"y2"`
 }
 
+// AdjacencyDto lists which elements of a map border each other.
+type AdjacencyDto struct {
+	Map        string              `json:"map"`
+	Tolerance  float64             `json:"tolerance"`
+	Overrides  []AdjacencyOverride `json:"overrides"`
+	Neighbours map[string][]string `json:"neighbours"`
+}
+
+// AdjacencyOverride forces two map elements to border, or not, regardless of
+// what their outlines say.
+type AdjacencyOverride struct {
+	Element   string `json:"element"`
+	Neighbour string `json:"neighbour"`
+	Adjacent  bool   `json:"adjacent"`
+}
+
 type MappingEntryDto struct {
 	ID               int             `json:"id"`
 	GroupID          int             `json:"groupId"`
@@ -135,6 +208,9 @@ type TriviaQuestion struct {
 	ImageHeight        int    `json:"imageHeight"`
 	ImageAlt           string `json:"imageAlt"`
 	Explainer          string `json:"explainer"`
+	Generator          string `json:"generator"`
+	ManualQuestionID   int    `json:"manualQuestionId"`
+	DistractorStrategy string `json:"distractorStrategy"`
 }
 
 type TriviaAnswer struct {
@@ -153,6 +229,52 @@ type ManualTriviaAnswer struct {
 	FlagCode               string `json:"flagCode"`
 }
 
+type Recipe struct {
+	Name          string       `json:"name" yaml:"name"`
+	QuestionCount int          `json:"questionCount" yaml:"questionCount"`
+	Slots         []RecipeSlot `json:"slots" yaml:"slots"`
+}
+
+type RecipeSlot struct {
+	Generator  string   `json:"generator,omitempty" yaml:"generator,omitempty"`
+	Manual     string   `json:"manual,omitempty" yaml:"manual,omitempty"`
+	Count      int      `json:"count,omitempty" yaml:"count,omitempty"`
+	Share      float64  `json:"share,omitempty" yaml:"share,omitempty"`
+	Categories []string `json:"categories,omitempty" yaml:"categories,omitempty"`
+}
+
+// FlagSimilarityGroup is a set of flags, by code, that are easily confused with
+// each other.
+type FlagSimilarityGroup struct {
+	ID    int      `json:"id"`
+	Name  string   `json:"name"`
+	Codes []string `json:"codes"`
+}
+
+// HighlightedRegion configures a generator that highlights an element of Map and
+// asks which Noun it is, answering from the MappingKey mapping group. Targets
+// limits the elements that can be highlighted; empty allows every entry.
+type HighlightedRegion struct {
+	Name       string   `json:"name"`
+	MappingKey string   `json:"mappingKey"`
+	Map        string   `json:"map"`
+	Noun       string   `json:"noun"`
+	Targets    []string `json:"targets,omitempty"`
+}
+
+// SizeComparison configures a generator that shows Map and asks which of four
+// Noun from the MappingKey mapping group appears largest, or smallest when
+// Smallest is set. The correct answer must beat every wrong answer by Margin, a
+// fraction of its area that defaults to 0.2 when zero.
+type SizeComparison struct {
+	Name       string  `json:"name"`
+	MappingKey string  `json:"mappingKey"`
+	Map        string  `json:"map"`
+	Noun       string  `json:"noun"`
+	Smallest   bool    `json:"smallest,omitempty"`
+	Margin     float64 `json:"margin,omitempty"`
+}
+
 var TopLandmass = []string{
 	"Russia",
 	"Canada",
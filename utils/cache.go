@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/geobuff/generate/storage"
+)
+
+// referenceCache is implemented by stores that cache reference data, such as
+// storage.CachedStore.
+type referenceCache interface {
+	CacheStats() map[string]storage.CacheStats
+	Invalidate(kind string) error
+}
+
+func (s *Service) referenceCache() (referenceCache, error) {
+	cache, ok := s.store.(referenceCache)
+	if !ok {
+		return nil, NewServiceError(ERROR_CODE_NOT_FOUND, ErrCacheDisabled, nil, "reference data cache is not enabled")
+	}
+	return cache, nil
+}
+
+func (s *Service) GetCacheStats(ctx context.Context) (map[string]storage.CacheStats, error) {
+	cache, err := s.referenceCache()
+	if err != nil {
+		return nil, err
+	}
+	return cache.CacheStats(), nil
+}
+
+// InvalidateCache drops the cached reference data of the given kind so it is
+// reloaded on next use. An empty kind drops everything. Dropping maps also drops
+// the geometry worked out from them.
+func (s *Service) InvalidateCache(ctx context.Context, kind string) error {
+	cache, err := s.referenceCache()
+	if err != nil {
+		return err
+	}
+
+	if kind != "" && !isCacheKind(kind) {
+		return validationError(map[string]interface{}{"kind": kind, "kinds": storage.CacheKinds}, "unknown cache kind %s", kind)
+	}
+
+	if kind == "" || kind == storage.CACHE_KIND_MAPS {
+		s.geometry.invalidate()
+	}
+	return cache.Invalidate(kind)
+}
+
+func isCacheKind(kind string) bool {
+	for _, val := range storage.CacheKinds {
+		if val == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+func TestInvalidateCache(t *testing.T) {
+	tt := []struct {
+		name     string
+		store    storage.IStore
+		kind     string
+		expected error
+	}{
+		{
+			name:     "cache disabled",
+			store:    storage.NewSeededMemoryStore(),
+			kind:     "",
+			expected: ErrCacheDisabled,
+		},
+		{
+			name:     "unknown kind",
+			store:    storage.NewCachedStore(storage.NewSeededMemoryStore(), storage.DefaultCacheTTLs),
+			kind:     "flags",
+			expected: ErrInvalidInput,
+		},
+		{
+			name:     "happy path",
+			store:    storage.NewCachedStore(storage.NewSeededMemoryStore(), storage.DefaultCacheTTLs),
+			kind:     storage.CACHE_KIND_MAPS,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewService(tc.store)
+
+			err := service.InvalidateCache(context.Background(), tc.kind)
+			if !errors.Is(err, tc.expected) {
+				t.Errorf("expected error %v; got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestGetCacheStatsCountsGeneration(t *testing.T) {
+	service := NewService(storage.NewCachedStore(storage.NewSeededMemoryStore(), storage.DefaultCacheTTLs))
+
+	for i := 0; i < 2; i++ {
+		if _, err := service.PreviewTrivia(context.Background(), "2022-01-01", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := service.GetCacheStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := stats[storage.CACHE_KIND_MAPPING_ENTRIES]
+	if entries.Misses != int64(entries.Entries) || entries.Hits == 0 {
+		t.Errorf("expected one miss per mapping group and hits on the second preview; got %+v", entries)
+	}
+}
+
+// mapCountingStore counts the maps read from the wrapped store.
+type mapCountingStore struct {
+	storage.IStore
+	reads map[string]int
+}
+
+func (s *mapCountingStore) GetMap(ctx context.Context, className string) (types.MapDto, error) {
+	s.reads[className]++
+	return s.IStore.GetMap(ctx, className)
+}
+
+func TestGetTriviaReadsMapsThroughCache(t *testing.T) {
+	ctx := context.Background()
+	memory := storage.NewSeededMemoryStore()
+	if err := NewService(memory).CreateTrivia(ctx, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	inner := &mapCountingStore{memory, make(map[string]int)}
+	service := NewService(storage.NewCachedStore(inner, storage.DefaultCacheTTLs))
+	date := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	for i := 0; i < 2; i++ {
+		trivia, err := service.GetTrivia(ctx, date)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, question := range trivia.Questions {
+			if question.Map.ClassName != question.MapName {
+				t.Errorf("expected question %d to show map %q; got %q", question.ID, question.MapName, question.Map.ClassName)
+			}
+		}
+	}
+
+	if len(inner.reads) == 0 {
+		t.Fatal("expected the trivia to have map questions")
+	}
+
+	for className, reads := range inner.reads {
+		if reads != 1 {
+			t.Errorf("expected map %s to be read once; got %d", className, reads)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package utils
+
+import "time"
+
+// Clock tells the service what the time is in the quiz timezone, which decides
+// what "today" and "tomorrow" mean when choosing quiz dates.
+type Clock interface {
+	Now() time.Time
+}
+
+type zonedClock struct {
+	location *time.Location
+}
+
+// NewClock returns a clock reading the system time in the given location.
+func NewClock(location *time.Location) Clock {
+	return zonedClock{location}
+}
+
+func (c zonedClock) Now() time.Time {
+	return time.Now().In(c.location)
+}
+
+// tomorrow returns the date of the next quiz in the quiz timezone.
+func (s *Service) tomorrow() time.Time {
+	year, month, day := s.clock.Now().Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,209 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// defaultSizeComparisonMargin is how much larger, or smaller, the correct answer
+// must be than every other option when a comparison does not set its own margin.
+const defaultSizeComparisonMargin = 0.2
+
+// defaultSizeComparisons are registered with every service.
+var defaultSizeComparisons = []types.SizeComparison{
+	{Name: "largest-country", MappingKey: "world-countries", Map: "WorldCountries", Noun: "countries"},
+	{Name: "smallest-country", MappingKey: "world-countries", Map: "WorldCountries", Noun: "countries", Smallest: true},
+	{Name: "largest-us-state", MappingKey: "us-states", Map: "UsStates", Noun: "US states"},
+	{Name: "smallest-us-state", MappingKey: "us-states", Map: "UsStates", Noun: "US states", Smallest: true},
+}
+
+// LoadSizeComparisons reads a JSON array of size comparison generators from path.
+func LoadSizeComparisons(path string) ([]types.SizeComparison, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var comparisons []types.SizeComparison
+	if err := json.Unmarshal(data, &comparisons); err != nil {
+		return nil, fmt.Errorf("invalid size comparisons %s: %v", path, err)
+	}
+	return comparisons, nil
+}
+
+// AddSizeComparison checks the comparison against the store and registers a
+// generator for it under its name.
+func (s *Service) AddSizeComparison(ctx context.Context, comparison types.SizeComparison) error {
+	if err := validateSizeComparison(ctx, s.store, comparison); err != nil {
+		return fmt.Errorf("invalid size comparison %s: %v", comparison.Name, err)
+	}
+	return s.generators.Register(comparison.Name, sizeQuestion(s.geometry, comparison))
+}
+
+// defaultSizeComparison returns the generator for a built-in comparison, which
+// like a built-in highlighted region is checked the first time it generates.
+func defaultSizeComparison(geometries *geometryCache, comparison types.SizeComparison) QuestionGenerator {
+	return newCheckedGenerator(func(g *Generation) error {
+		if err := validateSizeComparison(g.ctx, g.store, comparison); err != nil {
+			return fmt.Errorf("invalid size comparison %s: %v", comparison.Name, err)
+		}
+		return nil
+	}, sizeQuestion(geometries, comparison))
+}
+
+func validateSizeComparison(ctx context.Context, store storage.IStore, comparison types.SizeComparison) error {
+	if comparison.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if comparison.MappingKey == "" || comparison.Map == "" || comparison.Noun == "" {
+		return fmt.Errorf("mappingKey, map and noun are required")
+	}
+
+	if comparison.Margin < 0 {
+		return fmt.Errorf("margin cannot be negative")
+	}
+
+	_, err := validateMapping(ctx, store, comparison.MappingKey, comparison.Map)
+	return err
+}
+
+// sizeQuestion shows the map and asks which of four of its elements, named in the
+// question, appears largest or smallest. Areas are measured on the projected
+// outlines, which on a world map are far from true areas, so the question is only
+// about the map.
+func sizeQuestion(geometries *geometryCache, comparison types.SizeComparison) QuestionGeneratorFunc {
+	margin := comparison.Margin
+	if margin == 0 {
+		margin = defaultSizeComparisonMargin
+	}
+
+	return func(g *Generation) (int, error) {
+		shapes, err := geometries.shapes(g.ctx, g.store, comparison.Map)
+		if err != nil {
+			return 0, err
+		}
+
+		entries, err := g.MappingEntries(comparison.MappingKey)
+		if err != nil {
+			return 0, err
+		}
+
+		var options []types.MappingEntryDto
+		areas := make(map[string]float64)
+		for _, val := range entries {
+			if shape, ok := shapes[val.SVGName]; ok {
+				if area := shape.Area(); area > 0 {
+					options = append(options, val)
+					areas[val.SVGName] = area
+				}
+			}
+		}
+
+		if len(options) < 4 {
+			return 0, fmt.Errorf("map %s does not have enough %s with an area to compare", comparison.Map, comparison.Noun)
+		}
+
+		// Only options far enough apart in size from the correct answer can be
+		// offered as wrong answers, so near ties are never asked.
+		farApart := func(correct, other types.MappingEntryDto) bool {
+			if comparison.Smallest {
+				return areas[other.SVGName] >= areas[correct.SVGName]*(1+margin)
+			}
+			return areas[correct.SVGName] >= areas[other.SVGName]*(1+margin)
+		}
+
+		type candidate struct {
+			correct   types.MappingEntryDto
+			incorrect []types.MappingEntryDto
+		}
+
+		var candidates []candidate
+		for _, correct := range options {
+			c := candidate{correct: correct}
+			for _, other := range options {
+				if farApart(correct, other) {
+					c.incorrect = append(c.incorrect, other)
+				}
+			}
+
+			if len(c.incorrect) >= 3 {
+				candidates = append(candidates, c)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return 0, fmt.Errorf("unable to find %s on map %s far enough apart in size", comparison.Noun, comparison.Map)
+		}
+
+		c := candidates[rand.Intn(len(candidates))]
+		wrong, strategy, err := g.Distractors(DistractorPool{c.correct, c.incorrect, comparison.Map}, 3)
+		if err != nil {
+			return 0, err
+		}
+
+		// The whole map is shown, so the question names the options to look for.
+		// They are sorted so that their order gives nothing away.
+		names := []string{c.correct.SVGName}
+		for _, val := range wrong {
+			names = append(names, val.SVGName)
+		}
+		sort.Strings(names)
+
+		size := "largest"
+		if comparison.Smallest {
+			size = "smallest"
+		}
+
+		question := types.TriviaQuestion{
+			TypeID:             types.QUESTION_TYPE_MAP,
+			Question:           fmt.Sprintf("Which of these %s appears %s on the map: %s?", comparison.Noun, size, joinOptions(names)),
+			Map:                comparison.Map,
+			DistractorStrategy: strategy,
+		}
+
+		questionId, err := g.CreateQuestion(question)
+		if err != nil {
+			return 0, err
+		}
+
+		answer := types.TriviaAnswer{
+			TriviaQuestionID: questionId,
+			Text:             c.correct.SVGName,
+			IsCorrect:        true,
+		}
+		if err := g.CreateAnswer(answer); err != nil {
+			return 0, err
+		}
+
+		for _, val := range wrong {
+			answer := types.TriviaAnswer{
+				TriviaQuestionID: questionId,
+				Text:             val.SVGName,
+				IsCorrect:        false,
+			}
+
+			if err := g.CreateAnswer(answer); err != nil {
+				return 0, err
+			}
+		}
+
+		return 1, nil
+	}
+}
+
+// joinOptions lists names as "a, b, c or d".
+func joinOptions(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
@@ -0,0 +1,234 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// newComparisonStore returns a store whose US states map is a row of squares with
+// the given sides.
+func newComparisonStore(sides []float64) *storage.MemoryStore {
+	var names []string
+	var paths []string
+	x := 0.0
+	for i, side := range sides {
+		names = append(names, fmt.Sprintf("State %d", i))
+		paths = append(paths, square(x, 0, side))
+		x = x + side + 10
+	}
+
+	return newGridStore("us-states", "UsStates", names, func(i int) string {
+		return paths[i]
+	})
+}
+
+func TestSizeQuestions(t *testing.T) {
+	// The last two states are a near tie that must never decide a question.
+	sides := []float64{1, 2, 3, 4, 5, 6, 10, 10.2}
+
+	tt := []struct {
+		name       string
+		comparison types.SizeComparison
+	}{
+		{
+			name:       "largest",
+			comparison: types.SizeComparison{Name: "largest-state", MappingKey: "us-states", Map: "UsStates", Noun: "US states"},
+		},
+		{
+			name:       "smallest",
+			comparison: types.SizeComparison{Name: "smallest-state", MappingKey: "us-states", Map: "UsStates", Noun: "US states", Smallest: true},
+		},
+		{
+			name:       "wide margin",
+			comparison: types.SizeComparison{Name: "largest-state", MappingKey: "us-states", Map: "UsStates", Noun: "US states", Margin: 2},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newComparisonStore(sides)
+			service := NewService(store)
+			if err := service.AddSizeComparison(ctx, tc.comparison); err != nil {
+				t.Fatal(err)
+			}
+
+			generator, err := service.Generators().Get(tc.comparison.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			g := newTestGeneration(t, store)
+			for i := 0; i < 20; i++ {
+				if _, err := g.runGenerator(tc.comparison.Name, generator); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			trivia, err := store.GetTrivia(ctx, "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			areas := make(map[string]float64)
+			for i, side := range sides {
+				areas[fmt.Sprintf("State %d", i)] = side * side
+			}
+
+			margin := tc.comparison.Margin
+			if margin == 0 {
+				margin = defaultSizeComparisonMargin
+			}
+
+			for _, question := range trivia.Questions {
+				if !strings.HasSuffix(question.Question, "?") || question.MapName != "UsStates" {
+					t.Errorf("expected a question about the UsStates map; got %q on %s", question.Question, question.MapName)
+				}
+
+				if len(question.Answers) != 4 {
+					t.Fatalf("expected 4 answers; got %d", len(question.Answers))
+				}
+
+				var correct float64
+				for _, answer := range question.Answers {
+					if !strings.Contains(question.Question, answer.Text) {
+						t.Errorf("expected %q to name %s", question.Question, answer.Text)
+					}
+
+					if answer.IsCorrect {
+						correct = areas[answer.Text]
+					}
+				}
+
+				for _, answer := range question.Answers {
+					if answer.IsCorrect {
+						continue
+					}
+
+					other := areas[answer.Text]
+					if !tc.comparison.Smallest && correct < other*(1+margin) {
+						t.Errorf("%q: expected %v to be well above %v", question.Question, correct, other)
+					}
+
+					if tc.comparison.Smallest && other < correct*(1+margin) {
+						t.Errorf("%q: expected %v to be well below %v", question.Question, correct, other)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestAddSizeComparison(t *testing.T) {
+	comparison := types.SizeComparison{Name: "largest-state", MappingKey: "us-states", Map: "UsStates", Noun: "US states"}
+
+	tt := []struct {
+		name       string
+		comparison func() types.SizeComparison
+		expected   string
+	}{
+		{
+			name:       "missing name",
+			comparison: func() types.SizeComparison { c := comparison; c.Name = ""; return c },
+			expected:   "invalid size comparison : name cannot be empty",
+		},
+		{
+			name:       "missing map",
+			comparison: func() types.SizeComparison { c := comparison; c.Map = ""; return c },
+			expected:   "invalid size comparison largest-state: mappingKey, map and noun are required",
+		},
+		{
+			name:       "negative margin",
+			comparison: func() types.SizeComparison { c := comparison; c.Margin = -0.1; return c },
+			expected:   "invalid size comparison largest-state: margin cannot be negative",
+		},
+		{
+			name:       "unknown map",
+			comparison: func() types.SizeComparison { c := comparison; c.Map = "Unknown"; return c },
+			expected:   "invalid size comparison largest-state: map Unknown does not exist",
+		},
+		{
+			name:       "already registered",
+			comparison: func() types.SizeComparison { c := comparison; c.Name = "largest-country"; return c },
+			expected:   "question generator largest-country is already registered",
+		},
+		{
+			name:       "happy path",
+			comparison: func() types.SizeComparison { return comparison },
+			expected:   "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewService(newComparisonStore([]float64{1, 2, 3, 4}))
+			err := service.AddSizeComparison(context.Background(), tc.comparison())
+			if tc.expected == "" {
+				if err != nil {
+					t.Fatalf("expected nil error; got %v", err)
+				}
+
+				if _, err := service.Generators().Get("largest-state"); err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("expected error %q; got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestLoadSizeComparisons(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "comparisons.json")
+	comparisons := `[{"name": "smallest-state", "mappingKey": "us-states", "map": "UsStates", "noun": "US states", "smallest": true, "margin": 0.5}]`
+	if err := os.WriteFile(path, []byte(comparisons), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := LoadSizeComparisons(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != 1 || !result[0].Smallest || result[0].Margin != 0.5 {
+		t.Fatalf("unexpected comparisons %+v", result)
+	}
+
+	service := NewService(newComparisonStore([]float64{1, 2, 3, 4}))
+	if err := service.AddSizeComparison(context.Background(), result[0]); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSizeQuestionNotEnoughElements(t *testing.T) {
+	store := newComparisonStore([]float64{1, 2, 3})
+	service := NewService(store)
+	generator, err := service.Generators().Get("largest-us-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := generator.Generate(newTestGeneration(t, store)); err == nil {
+		t.Error("expected error; got nil")
+	}
+}
+
+func TestDefaultSizeComparisons(t *testing.T) {
+	for _, comparison := range defaultSizeComparisons {
+		t.Run(comparison.Name, func(t *testing.T) {
+			if err := validateSizeComparison(context.Background(), storage.NewSeededMemoryStore(), comparison); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateInput is a quiz date as given by a caller: an ISO date such as 2022-01-13,
+// one of today, tomorrow or yesterday, or a number of days relative to today such
+// as +3d or -1d.
+type DateInput string
+
+// Resolve returns the calendar date the input refers to, taking today from now.
+func (d DateInput) Resolve(now time.Time) (time.Time, error) {
+	input := strings.ToLower(strings.TrimSpace(string(d)))
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	switch input {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if strings.HasSuffix(input, "d") && (strings.HasPrefix(input, "+") || strings.HasPrefix(input, "-")) {
+		days, err := strconv.Atoi(strings.TrimSuffix(input, "d"))
+		if err != nil || days > maxRelativeDays || days < -maxRelativeDays {
+			return time.Time{}, invalidDateError(string(d))
+		}
+		return today.AddDate(0, 0, days), nil
+	}
+
+	date, err := time.Parse("2006-01-02", input)
+	if err != nil {
+		return time.Time{}, invalidDateError(string(d))
+	}
+	return date, nil
+}
+
+// maxRelativeDays bounds relative inputs so that a typo cannot land on a date
+// thousands of years away.
+const maxRelativeDays = 3660
+
+// parseDate resolves a date input against the service clock.
+func (s *Service) parseDate(input string) (time.Time, error) {
+	return DateInput(input).Resolve(s.clock.Now())
+}
+
+// parseDateRange resolves both ends of a date range and checks they are in order.
+// An empty end is left as the zero time.
+func (s *Service) parseDateRange(from, to string) (time.Time, time.Time, error) {
+	var fromDate, toDate time.Time
+	var err error
+	if from != "" {
+		if fromDate, err = s.parseDate(from); err != nil {
+			return fromDate, toDate, err
+		}
+	}
+
+	if to != "" {
+		if toDate, err = s.parseDate(to); err != nil {
+			return fromDate, toDate, err
+		}
+	}
+
+	if from != "" && to != "" && fromDate.After(toDate) {
+		return fromDate, toDate, validationError(map[string]interface{}{"from": from, "to": to}, "from %s is after to %s", from, to)
+	}
+	return fromDate, toDate, nil
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDateInputResolve(t *testing.T) {
+	now := time.Date(2022, 1, 31, 23, 0, 0, 0, time.FixedZone("NZDT", 13*60*60))
+
+	tt := []struct {
+		name     string
+		input    DateInput
+		expected string
+		err      error
+	}{
+		{name: "iso date", input: "2022-01-13", expected: "2022-01-13"},
+		{name: "today", input: "today", expected: "2022-01-31"},
+		{name: "tomorrow", input: "Tomorrow", expected: "2022-02-01"},
+		{name: "yesterday", input: "yesterday", expected: "2022-01-30"},
+		{name: "days ahead", input: "+3d", expected: "2022-02-03"},
+		{name: "days behind", input: "-31d", expected: "2021-12-31"},
+		{name: "empty", input: "", err: ErrInvalidInput},
+		{name: "day and month swapped", input: "2022-13-01", err: ErrInvalidInput},
+		{name: "relative without sign", input: "3d", err: ErrInvalidInput},
+		{name: "relative without unit", input: "+3", err: ErrInvalidInput},
+		{name: "relative too far", input: "+100000d", err: ErrInvalidInput},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			date, err := tc.input.Resolve(now)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v; got %v", tc.err, err)
+			}
+
+			if tc.err == nil && date.Format("2006-01-02") != tc.expected {
+				t.Errorf("expected %s; got %s", tc.expected, date.Format("2006-01-02"))
+			}
+		})
+	}
+}
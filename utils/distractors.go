@@ -0,0 +1,276 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/geobuff/generate/types"
+)
+
+// distractorShortlist is how many of the best ranked candidates the nearby and
+// similar name strategies pick from, so the same target does not always get the
+// same wrong answers.
+const distractorShortlist = 8
+
+// DistractorPool is what a strategy picks wrong answers from: the right answer,
+// the entries that could stand in for it and the map the entries are drawn on.
+type DistractorPool struct {
+	Target     types.MappingEntryDto
+	Candidates []types.MappingEntryDto
+	Map        string
+}
+
+// DistractorStrategy picks the wrong answers of a question. Choose must return
+// count distinct candidates; callers make sure there are enough.
+type DistractorStrategy interface {
+	Name() string
+	Choose(g *Generation, pool DistractorPool, count int) ([]types.MappingEntryDto, error)
+}
+
+// newDistractorStrategy returns the strategy with the given name.
+func newDistractorStrategy(name string, geometries *geometryCache) (DistractorStrategy, error) {
+	switch name {
+	case types.DISTRACTOR_STRATEGY_RANDOM:
+		return randomDistractors{}, nil
+	case types.DISTRACTOR_STRATEGY_GROUPING:
+		return groupingDistractors{}, nil
+	case types.DISTRACTOR_STRATEGY_NEARBY:
+		return nearbyDistractors{geometries}, nil
+	case types.DISTRACTOR_STRATEGY_SIMILAR_NAME:
+		return similarNameDistractors{}, nil
+	case types.DISTRACTOR_STRATEGY_SIMILAR_FLAG:
+		return similarFlagDistractors{}, nil
+	}
+	return nil, fmt.Errorf("unknown distractor strategy %s", name)
+}
+
+// SetDistractorStrategy makes the named generator pick its wrong answers with
+// the named strategy. Generators without a strategy pick at random, apart from
+// pick-flag which uses similar-flag.
+func (s *Service) SetDistractorStrategy(generator, strategy string) error {
+	if _, err := s.generators.Get(generator); err != nil {
+		return err
+	}
+
+	impl, err := newDistractorStrategy(strategy, s.geometry)
+	if err != nil {
+		return err
+	}
+
+	s.distractors[generator] = impl
+	return nil
+}
+
+// Distractors picks count wrong answers from the pool with the strategy set for
+// the running generator and returns them with the name of the strategy used, so
+// it can be saved against the question.
+func (g *Generation) Distractors(pool DistractorPool, count int) ([]types.MappingEntryDto, string, error) {
+	if len(pool.Candidates) < count {
+		return nil, "", fmt.Errorf("need %d candidates for %s wrong answers; got %d", count, pool.Target.SVGName, len(pool.Candidates))
+	}
+
+	strategy, ok := g.distractors[g.generator]
+	if !ok {
+		strategy = randomDistractors{}
+	}
+
+	result, err := strategy.Choose(g, pool, count)
+	if err != nil {
+		return nil, "", err
+	}
+	return result, strategy.Name(), nil
+}
+
+// randomDistractors picks any of the candidates.
+type randomDistractors struct{}
+
+func (randomDistractors) Name() string {
+	return types.DISTRACTOR_STRATEGY_RANDOM
+}
+
+func (randomDistractors) Choose(g *Generation, pool DistractorPool, count int) ([]types.MappingEntryDto, error) {
+	return shuffled(pool.Candidates)[:count], nil
+}
+
+// groupingDistractors prefers candidates in the same grouping, such as the same
+// continent, as the target.
+type groupingDistractors struct{}
+
+func (groupingDistractors) Name() string {
+	return types.DISTRACTOR_STRATEGY_GROUPING
+}
+
+func (groupingDistractors) Choose(g *Generation, pool DistractorPool, count int) ([]types.MappingEntryDto, error) {
+	var same, other []types.MappingEntryDto
+	for _, val := range pool.Candidates {
+		if pool.Target.Grouping != "" && val.Grouping == pool.Target.Grouping {
+			same = append(same, val)
+		} else {
+			other = append(other, val)
+		}
+	}
+	return append(shuffled(same), shuffled(other)...)[:count], nil
+}
+
+// nearbyDistractors prefers candidates whose outline is centred close to the
+// target's on the pool's map. Candidates without an outline come last and a
+// target without one falls back to random.
+type nearbyDistractors struct {
+	geometries *geometryCache
+}
+
+func (nearbyDistractors) Name() string {
+	return types.DISTRACTOR_STRATEGY_NEARBY
+}
+
+func (s nearbyDistractors) Choose(g *Generation, pool DistractorPool, count int) ([]types.MappingEntryDto, error) {
+	shapes, err := s.geometries.shapes(g.ctx, g.store, pool.Map)
+	if err != nil {
+		return nil, err
+	}
+
+	target, ok := shapes[pool.Target.SVGName]
+	if !ok {
+		return randomDistractors{}.Choose(g, pool, count)
+	}
+
+	centre := target.Centroid()
+	distance := func(entry types.MappingEntryDto) float64 {
+		shape, ok := shapes[entry.SVGName]
+		if !ok {
+			return math.Inf(1)
+		}
+		point := shape.Centroid()
+		return math.Hypot(point.X-centre.X, point.Y-centre.Y)
+	}
+	return shortlist(pool.Candidates, distance, count), nil
+}
+
+// similarNameDistractors prefers candidates whose name is spelt like the target's.
+type similarNameDistractors struct{}
+
+func (similarNameDistractors) Name() string {
+	return types.DISTRACTOR_STRATEGY_SIMILAR_NAME
+}
+
+func (similarNameDistractors) Choose(g *Generation, pool DistractorPool, count int) ([]types.MappingEntryDto, error) {
+	name := strings.ToLower(pool.Target.SVGName)
+	distance := func(entry types.MappingEntryDto) float64 {
+		return float64(editDistance(name, strings.ToLower(entry.SVGName)))
+	}
+	return shortlist(pool.Candidates, distance, count), nil
+}
+
+// similarFlagDistractors prefers candidates whose flag shares a similarity group
+// with the target's, matching entries to flags by code.
+type similarFlagDistractors struct{}
+
+func (similarFlagDistractors) Name() string {
+	return types.DISTRACTOR_STRATEGY_SIMILAR_FLAG
+}
+
+func (similarFlagDistractors) Choose(g *Generation, pool DistractorPool, count int) ([]types.MappingEntryDto, error) {
+	groups, err := g.store.GetFlagSimilarityGroups(g.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	similar := make(map[string]bool)
+	for _, group := range groups {
+		inGroup := false
+		for _, val := range group.Codes {
+			if val == pool.Target.Code {
+				inGroup = true
+				break
+			}
+		}
+
+		if !inGroup {
+			continue
+		}
+
+		for _, val := range group.Codes {
+			similar[val] = true
+		}
+	}
+
+	var same, other []types.MappingEntryDto
+	for _, val := range pool.Candidates {
+		if similar[val.Code] && val.Code != pool.Target.Code {
+			same = append(same, val)
+		} else {
+			other = append(other, val)
+		}
+	}
+	return append(shuffled(same), shuffled(other)...)[:count], nil
+}
+
+// shortlist ranks the candidates by score, lowest first, and picks count of them
+// at random from the best ranked distractorShortlist, or count if that is more.
+func shortlist(candidates []types.MappingEntryDto, score func(types.MappingEntryDto) float64, count int) []types.MappingEntryDto {
+	ranked := shuffled(candidates)
+	scores := make(map[string]float64, len(ranked))
+	for _, val := range ranked {
+		scores[val.SVGName] = score(val)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i].SVGName] < scores[ranked[j].SVGName]
+	})
+
+	size := distractorShortlist
+	if size < count {
+		size = count
+	}
+	if size > len(ranked) {
+		size = len(ranked)
+	}
+	return shuffled(ranked[:size])[:count]
+}
+
+// shuffled returns the entries in random order without modifying them.
+func shuffled(entries []types.MappingEntryDto) []types.MappingEntryDto {
+	result := make([]types.MappingEntryDto, len(entries))
+	copy(result, entries)
+	rand.Shuffle(len(result), func(i, j int) {
+		result[i], result[j] = result[j], result[i]
+	})
+	return result
+}
+
+// editDistance is the number of single letter insertions, deletions and
+// substitutions needed to turn a into b.
+func editDistance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	previous := make([]int, len(y)+1)
+	current := make([]int, len(y)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		current[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(y)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, val := range values[1:] {
+		if val < result {
+			result = val
+		}
+	}
+	return result
+}
@@ -0,0 +1,275 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// newRowStore returns a store whose Row map is a row of twelve squares, R0 on
+// the left.
+func newRowStore() (*storage.MemoryStore, []types.MappingEntryDto) {
+	var names []string
+	for i := 0; i < 12; i++ {
+		names = append(names, fmt.Sprintf("R%d", i))
+	}
+
+	store := newGridStore("row", "Row", names, func(i int) string {
+		return square(float64(i*10), 0, 10)
+	})
+	return store, entriesNamed(names...)
+}
+
+func TestDistractorStrategies(t *testing.T) {
+	rowStore, row := newRowStore()
+	outlineStore, _ := newRowStore()
+
+	var continents []types.MappingEntryDto
+	for i, grouping := range []string{"Africa", "Africa", "Africa", "Africa", "Europe", "Europe", "Europe", "Europe", "Europe"} {
+		continents = append(continents, types.MappingEntryDto{Code: fmt.Sprint(i), SVGName: fmt.Sprintf("%s %d", grouping, i), Grouping: grouping})
+	}
+
+	names := entriesNamed("Guyana", "Ghana", "Gambia", "Kenya", "Uganda", "Guinea-Bissau", "Eritrea", "Bolivia",
+		"Saint Vincent and the Grenadines", "Bosnia and Herzegovina", "Central African Republic", "Democratic Republic of the Congo", "Sao Tome and Principe")
+
+	tt := []struct {
+		name     string
+		strategy string
+		store    *storage.MemoryStore
+		pool     DistractorPool
+		allowed  []string
+	}{
+		{
+			name:     "random",
+			strategy: types.DISTRACTOR_STRATEGY_RANDOM,
+			store:    storage.NewMemoryStore(),
+			pool:     DistractorPool{Target: row[0], Candidates: row[1:]},
+			allowed:  []string{"R1", "R2", "R3", "R4", "R5", "R6", "R7", "R8", "R9", "R10", "R11"},
+		},
+		{
+			name:     "grouping",
+			strategy: types.DISTRACTOR_STRATEGY_GROUPING,
+			store:    storage.NewMemoryStore(),
+			pool:     DistractorPool{Target: continents[0], Candidates: continents[1:]},
+			allowed:  []string{"Africa 1", "Africa 2", "Africa 3"},
+		},
+		{
+			name:     "nearby",
+			strategy: types.DISTRACTOR_STRATEGY_NEARBY,
+			store:    rowStore,
+			pool:     DistractorPool{Target: row[0], Candidates: row[1:], Map: "Row"},
+			allowed:  []string{"R1", "R2", "R3", "R4", "R5", "R6", "R7", "R8"},
+		},
+		{
+			name:     "nearby without outline",
+			strategy: types.DISTRACTOR_STRATEGY_NEARBY,
+			store:    outlineStore,
+			pool:     DistractorPool{Target: types.MappingEntryDto{SVGName: "Unknown"}, Candidates: row, Map: "Row"},
+			allowed:  []string{"R0", "R1", "R2", "R3", "R4", "R5", "R6", "R7", "R8", "R9", "R10", "R11"},
+		},
+		{
+			name:     "similar name",
+			strategy: types.DISTRACTOR_STRATEGY_SIMILAR_NAME,
+			store:    storage.NewMemoryStore(),
+			pool:     DistractorPool{Target: entriesNamed("Guinea")[0], Candidates: names},
+			allowed:  []string{"Guyana", "Ghana", "Gambia", "Kenya", "Uganda", "Guinea-Bissau", "Eritrea", "Bolivia"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy, err := newDistractorStrategy(tc.strategy, newGeometryCache())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			g := newTestGeneration(t, tc.store)
+			for i := 0; i < 20; i++ {
+				result, err := strategy.Choose(g, tc.pool, 3)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(result) != 3 {
+					t.Fatalf("expected 3 distractors; got %d", len(result))
+				}
+
+				seen := make(map[string]bool)
+				for _, val := range result {
+					if !containsString(tc.allowed, val.SVGName) {
+						t.Errorf("expected one of %v; got %s", tc.allowed, val.SVGName)
+					}
+
+					if seen[val.SVGName] {
+						t.Errorf("expected unique distractors; got %v", result)
+					}
+					seen[val.SVGName] = true
+				}
+			}
+		})
+	}
+}
+
+func TestSetDistractorStrategy(t *testing.T) {
+	tt := []struct {
+		name      string
+		generator string
+		strategy  string
+		expected  string
+		recorded  string
+	}{
+		{
+			name:      "unknown generator",
+			generator: "unknown",
+			strategy:  types.DISTRACTOR_STRATEGY_GROUPING,
+			expected:  "question generator unknown is not registered",
+		},
+		{
+			name:      "unknown strategy",
+			generator: "what-flag",
+			strategy:  "alphabetical",
+			expected:  "unknown distractor strategy alphabetical",
+		},
+		{
+			name:     "not configured",
+			recorded: types.DISTRACTOR_STRATEGY_RANDOM,
+		},
+		{
+			name:      "happy path",
+			generator: "what-flag",
+			strategy:  types.DISTRACTOR_STRATEGY_SIMILAR_NAME,
+			recorded:  types.DISTRACTOR_STRATEGY_SIMILAR_NAME,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := storage.NewSeededMemoryStore()
+			service := NewService(store)
+
+			if tc.generator != "" {
+				err := service.SetDistractorStrategy(tc.generator, tc.strategy)
+				if tc.expected != "" {
+					if err == nil || err.Error() != tc.expected {
+						t.Errorf("expected error %q; got %v", tc.expected, err)
+					}
+					return
+				}
+
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			generator, err := service.Generators().Get("what-flag")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			trivia := createTestTrivia(t, store, "2022-01-01", nil)
+			g := service.newGeneration(ctx, store, trivia.ID, trivia.Date)
+			if _, err := g.runGenerator("what-flag", generator); err != nil {
+				t.Fatal(err)
+			}
+
+			trivia, err = store.GetTrivia(ctx, "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			question, err := store.GetTriviaQuestion(ctx, trivia.Questions[0].ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if question.DistractorStrategy != tc.recorded {
+				t.Errorf("expected distractor strategy %s; got %s", tc.recorded, question.DistractorStrategy)
+			}
+		})
+	}
+}
+
+func TestGeometryGeneratorsUseDistractorStrategy(t *testing.T) {
+	tt := []struct {
+		name      string
+		generator string
+		store     *storage.MemoryStore
+	}{
+		{
+			name:      "which neighbour",
+			generator: "which-neighbour",
+			store:     newNeighbourStore(),
+		},
+		{
+			name:      "not neighbour",
+			generator: "not-neighbour",
+			store:     newNeighbourStore(),
+		},
+		{
+			name:      "largest",
+			generator: "largest-us-state",
+			store:     newComparisonStore([]float64{1, 2, 3, 4, 5, 6}),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			service := NewService(tc.store)
+			if err := service.SetDistractorStrategy(tc.generator, types.DISTRACTOR_STRATEGY_SIMILAR_NAME); err != nil {
+				t.Fatal(err)
+			}
+
+			generator, err := service.Generators().Get(tc.generator)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			trivia := createTestTrivia(t, tc.store, "2022-01-01", nil)
+			g := service.newGeneration(ctx, tc.store, trivia.ID, trivia.Date)
+			if _, err := g.runGenerator(tc.generator, generator); err != nil {
+				t.Fatal(err)
+			}
+
+			trivia, err = tc.store.GetTrivia(ctx, "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			question, err := tc.store.GetTriviaQuestion(ctx, trivia.Questions[0].ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if question.DistractorStrategy != types.DISTRACTOR_STRATEGY_SIMILAR_NAME {
+				t.Errorf("expected distractor strategy %s; got %q", types.DISTRACTOR_STRATEGY_SIMILAR_NAME, question.DistractorStrategy)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tt := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"", "", 0},
+		{"niger", "nigeria", 2},
+		{"guinea", "guyana", 3},
+		{"kitten", "sitting", 3},
+		{"côte", "cote", 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			if result := editDistance(tc.a, tc.b); result != tc.expected {
+				t.Errorf("expected %d; got %d", tc.expected, result)
+			}
+		})
+	}
+}
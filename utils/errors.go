@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/geobuff/generate/storage"
+)
+
+type ErrorCode string
+
+const (
+	ERROR_CODE_VALIDATION   ErrorCode = "validation"
+	ERROR_CODE_UNAUTHORIZED ErrorCode = "unauthorized"
+	ERROR_CODE_NOT_FOUND    ErrorCode = "not_found"
+	ERROR_CODE_CONFLICT     ErrorCode = "conflict"
+	ERROR_CODE_DEPENDENCY   ErrorCode = "dependency_failure"
+	ERROR_CODE_INTERNAL     ErrorCode = "internal"
+)
+
+var (
+	ErrTriviaExists         = errors.New("trivia already exists")
+	ErrGenerationInProgress = errors.New("trivia generation already in progress")
+	ErrTriviaNotFound       = errors.New("trivia not found")
+	ErrQuestionNotFound     = errors.New("question not found")
+	ErrInvalidInput         = errors.New("invalid input")
+	ErrNoReplacement        = errors.New("no replacement question available")
+	ErrCacheDisabled        = errors.New("reference data cache is not enabled")
+	ErrSchedulerDisabled    = errors.New("trivia scheduler is not enabled")
+	ErrMapNotFound          = errors.New("map not found")
+
+	// errPreviewRollback is returned from inside a preview transaction so that the
+	// store rolls back everything the preview generated.
+	errPreviewRollback = errors.New("preview rollback")
+)
+
+// ServiceError is an error the service expects callers to act on. The code says
+// what kind of failure it was, and the details carry the values involved.
+type ServiceError struct {
+	Code    ErrorCode
+	Message string
+	Details map[string]interface{}
+	Err     error
+}
+
+func NewServiceError(code ErrorCode, err error, details map[string]interface{}, format string, args ...interface{}) *ServiceError {
+	return &ServiceError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+		Details: details,
+		Err:     err,
+	}
+}
+
+func (e *ServiceError) Error() string {
+	return e.Message
+}
+
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+func validationError(details map[string]interface{}, format string, args ...interface{}) *ServiceError {
+	return NewServiceError(ERROR_CODE_VALIDATION, ErrInvalidInput, details, format, args...)
+}
+
+func invalidDateError(date string) *ServiceError {
+	return validationError(map[string]interface{}{"date": date}, "date %s must be in the format YYYY-MM-DD or one of today, tomorrow, yesterday or +Nd", date)
+}
+
+func triviaNotFoundError(date string) *ServiceError {
+	return NewServiceError(ERROR_CODE_NOT_FOUND, ErrTriviaNotFound, map[string]interface{}{"date": date}, "trivia for date %s does not exist", date)
+}
+
+func questionNotFoundError(date string, questionID int) *ServiceError {
+	details := map[string]interface{}{"date": date, "questionId": questionID}
+	return NewServiceError(ERROR_CODE_NOT_FOUND, ErrQuestionNotFound, details, "question %d is not part of the trivia for date %s", questionID, date)
+}
+
+// ToServiceError returns err as a ServiceError, classifying errors that did not
+// originate in the service by where they came from.
+func ToServiceError(err error) *ServiceError {
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) {
+		return serviceErr
+	}
+
+	var dbErr *storage.DatabaseError
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return NewServiceError(ERROR_CODE_NOT_FOUND, err, nil, "resource not found")
+	case errors.As(err, &dbErr):
+		return classifyDatabaseError(dbErr)
+	case errors.Is(err, context.DeadlineExceeded):
+		return NewServiceError(ERROR_CODE_DEPENDENCY, err, nil, "request timed out")
+	case errors.Is(err, context.Canceled):
+		return NewServiceError(ERROR_CODE_DEPENDENCY, err, nil, "request cancelled")
+	default:
+		return NewServiceError(ERROR_CODE_INTERNAL, err, nil, "an unexpected error occurred")
+	}
+}
+
+func classifyDatabaseError(err *storage.DatabaseError) *ServiceError {
+	var details map[string]interface{}
+	if err.State != "" {
+		details = map[string]interface{}{"sqlState": err.State}
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrConflict):
+		return NewServiceError(ERROR_CODE_CONFLICT, err, details, "conflicting data: %s", err.Message)
+	case errors.Is(err, storage.ErrUnavailable):
+		return NewServiceError(ERROR_CODE_DEPENDENCY, err, details, "database unavailable")
+	default:
+		return NewServiceError(ERROR_CODE_INTERNAL, err, details, "an unexpected error occurred")
+	}
+}
@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// QuestionGenerator creates one kind of auto-generated question and reports
+// how many questions it added to the trivia.
+type QuestionGenerator interface {
+	Generate(g *Generation) (int, error)
+}
+
+// QuestionGeneratorFunc allows an ordinary function to be used as a QuestionGenerator.
+type QuestionGeneratorFunc func(g *Generation) (int, error)
+
+func (f QuestionGeneratorFunc) Generate(g *Generation) (int, error) {
+	return f(g)
+}
+
+// checkedGenerator runs check before generating until it first passes, so that a
+// built-in generator whose data is missing from the store fails with the reason
+// rather than asking a broken question.
+type checkedGenerator struct {
+	check     func(g *Generation) error
+	generator QuestionGenerator
+
+	mu      sync.Mutex
+	checked bool
+}
+
+func newCheckedGenerator(check func(g *Generation) error, generator QuestionGenerator) *checkedGenerator {
+	return &checkedGenerator{check: check, generator: generator}
+}
+
+func (c *checkedGenerator) Generate(g *Generation) (int, error) {
+	c.mu.Lock()
+	if !c.checked {
+		if err := c.check(g); err != nil {
+			c.mu.Unlock()
+			return 0, err
+		}
+		c.checked = true
+	}
+	c.mu.Unlock()
+
+	return c.generator.Generate(g)
+}
+
+// GeneratorRegistry holds the question generators available to the service by name.
+type GeneratorRegistry struct {
+	names      []string
+	generators map[string]QuestionGenerator
+}
+
+func NewGeneratorRegistry() *GeneratorRegistry {
+	return &GeneratorRegistry{
+		generators: make(map[string]QuestionGenerator),
+	}
+}
+
+func (r *GeneratorRegistry) Register(name string, generator QuestionGenerator) error {
+	if name == "" {
+		return fmt.Errorf("question generator name cannot be empty")
+	}
+
+	if _, ok := r.generators[name]; ok {
+		return fmt.Errorf("question generator %s is already registered", name)
+	}
+
+	r.names = append(r.names, name)
+	r.generators[name] = generator
+	return nil
+}
+
+func (r *GeneratorRegistry) Get(name string) (QuestionGenerator, error) {
+	generator, ok := r.generators[name]
+	if !ok {
+		return nil, fmt.Errorf("question generator %s is not registered", name)
+	}
+	return generator, nil
+}
+
+// Names returns the registered generator names in registration order.
+func (r *GeneratorRegistry) Names() []string {
+	names := make([]string, len(r.names))
+	copy(names, r.names)
+	return names
+}
+
+// Generation is the state shared by the generators that build a single trivia.
+type Generation struct {
+	TriviaID       int
+	Date           time.Time
+	ctx            context.Context
+	generator      string
+	store          storage.IStore
+	entries        map[string][]types.MappingEntryDto
+	categories     []types.TriviaQuestionCategory
+	usedCategories map[int]bool
+	pinned         []types.TriviaQuestion
+	usedPins       map[int]bool
+	distractors    map[string]DistractorStrategy
+}
+
+func newGeneration(ctx context.Context, store storage.IStore, triviaID int, date time.Time) *Generation {
+	return &Generation{
+		TriviaID:       triviaID,
+		Date:           date,
+		ctx:            ctx,
+		store:          store,
+		entries:        make(map[string][]types.MappingEntryDto),
+		usedCategories: make(map[int]bool),
+		usedPins:       make(map[int]bool),
+	}
+}
+
+// newGeneration starts a generation that picks wrong answers with the distractor
+// strategies set on the service.
+func (s *Service) newGeneration(ctx context.Context, store storage.IStore, triviaID int, date time.Time) *Generation {
+	g := newGeneration(ctx, store, triviaID, date)
+	g.distractors = s.distractors
+	return g
+}
+
+func (g *Generation) Store() storage.IStore {
+	return g.store
+}
+
+// Context returns the context of the request the trivia is being generated for.
+// Generators calling the store directly should pass it along.
+func (g *Generation) Context() context.Context {
+	return g.ctx
+}
+
+// MappingEntries loads the entries for a mapping group once per generation and
+// returns a copy that the caller is free to modify.
+func (g *Generation) MappingEntries(key string) ([]types.MappingEntryDto, error) {
+	entries, ok := g.entries[key]
+	if !ok {
+		var err error
+		entries, err = g.store.GetMappingEntries(g.ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		g.entries[key] = entries
+	}
+
+	result := make([]types.MappingEntryDto, len(entries))
+	copy(result, entries)
+	return result, nil
+}
+
+// CreateQuestion saves a question against the trivia, recording the generator
+// currently running so the question can later be regenerated from the same source.
+func (g *Generation) CreateQuestion(question types.TriviaQuestion) (int, error) {
+	question.TriviaId = g.TriviaID
+	if question.Generator == "" {
+		question.Generator = g.generator
+	}
+	return g.store.CreateTriviaQuestion(g.ctx, question)
+}
+
+func (g *Generation) runGenerator(name string, generator QuestionGenerator) (int, error) {
+	g.generator = name
+	defer func() {
+		g.generator = ""
+	}()
+	return generator.Generate(g)
+}
+
+func (g *Generation) CreateAnswer(answer types.TriviaAnswer) error {
+	return g.store.CreateTriviaAnswer(g.ctx, answer)
+}
+
+// usePinned marks an unused pinned question from the named generator as filling
+// a slot, returning false if there is none.
+func (g *Generation) usePinned(generator string) bool {
+	for _, question := range g.pinned {
+		if question.Generator == generator && !g.usedPins[question.ID] {
+			g.usedPins[question.ID] = true
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generation) withoutPinnedManualQuestions(questions []types.ManualTriviaQuestion) []types.ManualTriviaQuestion {
+	if len(g.pinned) == 0 {
+		return questions
+	}
+
+	var result []types.ManualTriviaQuestion
+	for _, question := range questions {
+		pinned := false
+		for _, pin := range g.pinned {
+			if pin.ManualQuestionID == question.ID {
+				pinned = true
+				break
+			}
+		}
+
+		if !pinned {
+			result = append(result, question)
+		}
+	}
+	return result
+}
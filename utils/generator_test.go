@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+func TestGeneratorRegistryRegister(t *testing.T) {
+	tt := []struct {
+		name     string
+		register []string
+		expected string
+	}{
+		{
+			name:     "empty name",
+			register: []string{""},
+			expected: "question generator name cannot be empty",
+		},
+		{
+			name:     "duplicate name",
+			register: []string{"test", "test"},
+			expected: "question generator test is already registered",
+		},
+		{
+			name:     "happy path",
+			register: []string{"first", "second"},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := NewGeneratorRegistry()
+			generator := QuestionGeneratorFunc(func(g *Generation) (int, error) {
+				return 1, nil
+			})
+
+			var err error
+			for _, name := range tc.register {
+				if err = registry.Register(name, generator); err != nil {
+					break
+				}
+			}
+
+			if tc.expected == "" && err != nil {
+				t.Fatalf("expected no error; got %v", err)
+			}
+
+			if tc.expected != "" && (err == nil || err.Error() != tc.expected) {
+				t.Fatalf("expected error %q; got %v", tc.expected, err)
+			}
+
+			if tc.expected == "" && len(registry.Names()) != len(tc.register) {
+				t.Errorf("expected %d generators; got %d", len(tc.register), len(registry.Names()))
+			}
+		})
+	}
+}
+
+func TestRegisterDefaultGeneratorsReturnsError(t *testing.T) {
+	registry := NewGeneratorRegistry()
+	if err := registry.Register("what-flag", QuestionGeneratorFunc(whatFlag)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "question generator what-flag is already registered"
+	if err := registerDefaultGenerators(registry, newGeometryCache()); err == nil || err.Error() != expected {
+		t.Errorf("expected error %q; got %v", expected, err)
+	}
+}
+
+func TestCheckedGenerator(t *testing.T) {
+	checks := 0
+	generator := newCheckedGenerator(func(g *Generation) error {
+		checks++
+		if checks == 1 {
+			return errors.New("not ready")
+		}
+		return nil
+	}, QuestionGeneratorFunc(func(g *Generation) (int, error) {
+		return 1, nil
+	}))
+
+	if _, err := generator.Generate(nil); err == nil || err.Error() != "not ready" {
+		t.Fatalf("expected error %q; got %v", "not ready", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		count, err := generator.Generate(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if count != 1 {
+			t.Errorf("expected 1 question; got %d", count)
+		}
+	}
+
+	if checks != 2 {
+		t.Errorf("expected the check to stop running once it passed; ran %d times", checks)
+	}
+}
+
+func TestGenerateQuestionsUsesRegisteredGenerators(t *testing.T) {
+	tt := []struct {
+		name      string
+		generated int
+		err       error
+		expected  int
+	}{
+		{
+			name:      "error on generator",
+			generated: 0,
+			err:       errors.New("test"),
+			expected:  0,
+		},
+		{
+			name:      "counts reported questions",
+			generated: 2,
+			err:       nil,
+			expected:  2,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewService(storage.NewSeededMemoryStore())
+			service.Generators().Register("test", QuestionGeneratorFunc(func(g *Generation) (int, error) {
+				return tc.generated, tc.err
+			}))
+
+			recipe := types.Recipe{
+				Name:          "test",
+				QuestionCount: 10,
+				Slots:         []types.RecipeSlot{{Generator: "test", Count: 1}},
+			}
+
+			count, err := service.generateQuestions(newTestGeneration(t, service.store), recipe)
+			if err != tc.err {
+				t.Fatalf("expected error %v; got %v", tc.err, err)
+			}
+
+			if count != tc.expected {
+				t.Errorf("expected %d questions; got %d", tc.expected, count)
+			}
+		})
+	}
+}
+
+// newTestGeneration returns a generation for a new trivia in the store.
+func newTestGeneration(t *testing.T, store storage.IStore) *Generation {
+	trivia := createTestTrivia(t, store, "2022-01-01", nil)
+	return newGeneration(context.Background(), store, trivia.ID, trivia.Date)
+}
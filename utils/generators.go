@@ -0,0 +1,330 @@
+package utils
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/geobuff/generate/types"
+)
+
+func registerDefaultGenerators(registry *GeneratorRegistry, geometries *geometryCache) error {
+	type namedGenerator struct {
+		name      string
+		generator QuestionGenerator
+	}
+
+	var generators []namedGenerator
+	for _, region := range defaultHighlightedRegions {
+		generators = append(generators, namedGenerator{region.Name, defaultHighlightedRegion(region)})
+	}
+
+	generators = append(generators,
+		namedGenerator{"what-capital", QuestionGeneratorFunc(whatCapital)},
+		namedGenerator{"what-capital-country", QuestionGeneratorFunc(whatCapitalCountry)},
+		namedGenerator{"what-flag", QuestionGeneratorFunc(whatFlag)},
+		namedGenerator{"pick-flag", QuestionGeneratorFunc(pickFlag)},
+		namedGenerator{"which-neighbour", whichNeighbour(geometries)},
+		namedGenerator{"not-neighbour", notNeighbour(geometries)},
+	)
+
+	for _, comparison := range defaultSizeComparisons {
+		generators = append(generators, namedGenerator{comparison.Name, defaultSizeComparison(geometries, comparison)})
+	}
+
+	for _, val := range generators {
+		if err := registry.Register(val.name, val.generator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countryCapital pairs a world-countries mapping entry with the world-capitals
+// entry that has the same code.
+type countryCapital struct {
+	Country types.MappingEntryDto
+	Capital types.MappingEntryDto
+}
+
+// countryCapitals joins the world-countries and world-capitals mapping groups on
+// Code. Countries without a capital entry are left out.
+func countryCapitals(g *Generation) ([]countryCapital, error) {
+	countries, err := g.MappingEntries("world-countries")
+	if err != nil {
+		return nil, err
+	}
+
+	capitals, err := g.MappingEntries("world-capitals")
+	if err != nil {
+		return nil, err
+	}
+
+	capitalsByCode := make(map[string]types.MappingEntryDto)
+	for _, val := range capitals {
+		capitalsByCode[val.Code] = val
+	}
+
+	var result []countryCapital
+	for _, val := range countries {
+		if capital, ok := capitalsByCode[val.Code]; ok {
+			result = append(result, countryCapital{val, capital})
+		}
+	}
+	return result, nil
+}
+
+// randomTopLandmassCapital picks a country from the top landmass list and returns
+// its pair along with the remaining pairs.
+func randomTopLandmassCapital(g *Generation) (countryCapital, []countryCapital, error) {
+	pairs, err := countryCapitals(g)
+	if err != nil {
+		return countryCapital{}, nil, err
+	}
+
+	country := types.TopLandmass[rand.Intn(len(types.TopLandmass))]
+	for i, val := range pairs {
+		if val.Country.SVGName == country {
+			return val, append(pairs[:i], pairs[i+1:]...), nil
+		}
+	}
+	return countryCapital{}, nil, fmt.Errorf("unable to find capital for country %s in capital mappings", country)
+}
+
+// capitalDistractors picks three of the other pairs as wrong answers. Pairs are
+// compared by country so that the nearby strategy can use the world map.
+func capitalDistractors(g *Generation, pair countryCapital, others []countryCapital) ([]countryCapital, string, error) {
+	countries := make([]types.MappingEntryDto, len(others))
+	byCode := make(map[string]countryCapital)
+	for i, val := range others {
+		countries[i] = val.Country
+		byCode[val.Country.Code] = val
+	}
+
+	wrong, strategy, err := g.Distractors(DistractorPool{pair.Country, countries, "WorldCountries"}, 3)
+	if err != nil {
+		return nil, "", err
+	}
+
+	result := make([]countryCapital, len(wrong))
+	for i, val := range wrong {
+		result[i] = byCode[val.Code]
+	}
+	return result, strategy, nil
+}
+
+func whatCapital(g *Generation) (int, error) {
+	pair, others, err := randomTopLandmassCapital(g)
+	if err != nil {
+		return 0, err
+	}
+
+	wrong, strategy, err := capitalDistractors(g, pair, others)
+	if err != nil {
+		return 0, err
+	}
+
+	question := types.TriviaQuestion{
+		TypeID:             types.QUESTION_TYPE_MAP,
+		Question:           fmt.Sprintf("What is the capital city of %s?", pair.Country.SVGName),
+		Map:                "WorldCapitals",
+		Highlighted:        pair.Capital.SVGName,
+		DistractorStrategy: strategy,
+	}
+
+	questionId, err := g.CreateQuestion(question)
+	if err != nil {
+		return 0, err
+	}
+
+	answer := types.TriviaAnswer{
+		TriviaQuestionID: questionId,
+		Text:             pair.Capital.SVGName,
+		IsCorrect:        true,
+	}
+	err = g.CreateAnswer(answer)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, val := range wrong {
+		answer := types.TriviaAnswer{
+			TriviaQuestionID: questionId,
+			Text:             val.Capital.SVGName,
+			IsCorrect:        false,
+		}
+
+		err = g.CreateAnswer(answer)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return 1, nil
+}
+
+func whatCapitalCountry(g *Generation) (int, error) {
+	pair, others, err := randomTopLandmassCapital(g)
+	if err != nil {
+		return 0, err
+	}
+
+	wrong, strategy, err := capitalDistractors(g, pair, others)
+	if err != nil {
+		return 0, err
+	}
+
+	question := types.TriviaQuestion{
+		TypeID:             types.QUESTION_TYPE_MAP,
+		Question:           fmt.Sprintf("Which country has the capital city %s?", pair.Capital.SVGName),
+		Map:                "WorldCapitals",
+		Highlighted:        pair.Capital.SVGName,
+		DistractorStrategy: strategy,
+	}
+
+	questionId, err := g.CreateQuestion(question)
+	if err != nil {
+		return 0, err
+	}
+
+	answer := types.TriviaAnswer{
+		TriviaQuestionID: questionId,
+		Text:             pair.Country.SVGName,
+		IsCorrect:        true,
+	}
+	err = g.CreateAnswer(answer)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, val := range wrong {
+		answer := types.TriviaAnswer{
+			TriviaQuestionID: questionId,
+			Text:             val.Country.SVGName,
+			IsCorrect:        false,
+		}
+
+		err = g.CreateAnswer(answer)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return 1, nil
+}
+
+func whatFlag(g *Generation) (int, error) {
+	countries, err := g.MappingEntries("world-countries")
+	if err != nil {
+		return 0, err
+	}
+
+	if len(countries) == 0 {
+		return 0, fmt.Errorf("world-countries has no entries")
+	}
+
+	index := rand.Intn(len(countries))
+	country := countries[index]
+	countries = append(countries[:index], countries[index+1:]...)
+
+	wrong, strategy, err := g.Distractors(DistractorPool{country, countries, "WorldCountries"}, 3)
+	if err != nil {
+		return 0, err
+	}
+
+	question := types.TriviaQuestion{
+		TypeID:             types.QUESTION_TYPE_FLAG,
+		Question:           "Which country has this flag?",
+		FlagCode:           country.Code,
+		DistractorStrategy: strategy,
+	}
+
+	questionId, err := g.CreateQuestion(question)
+	if err != nil {
+		return 0, err
+	}
+
+	answer := types.TriviaAnswer{
+		TriviaQuestionID: questionId,
+		Text:             country.SVGName,
+		IsCorrect:        true,
+	}
+	err = g.CreateAnswer(answer)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, val := range wrong {
+		answer := types.TriviaAnswer{
+			TriviaQuestionID: questionId,
+			Text:             val.SVGName,
+			IsCorrect:        false,
+		}
+
+		err = g.CreateAnswer(answer)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return 1, nil
+}
+
+// pickFlag names a country and asks for its flag. Answers have no text so that
+// only their flags are shown. It picks wrong answers with the similar-flag
+// strategy unless configured otherwise, so the right flag cannot be spotted at a
+// glance.
+func pickFlag(g *Generation) (int, error) {
+	countries, err := g.MappingEntries("world-countries")
+	if err != nil {
+		return 0, err
+	}
+
+	if len(countries) == 0 {
+		return 0, fmt.Errorf("world-countries has no entries")
+	}
+
+	index := rand.Intn(len(countries))
+	country := countries[index]
+	countries = append(countries[:index], countries[index+1:]...)
+
+	wrong, strategy, err := g.Distractors(DistractorPool{country, countries, "WorldCountries"}, 3)
+	if err != nil {
+		return 0, err
+	}
+
+	question := types.TriviaQuestion{
+		TypeID:             types.QUESTION_TYPE_TEXT,
+		Question:           fmt.Sprintf("Which is the flag of %s?", country.SVGName),
+		DistractorStrategy: strategy,
+	}
+
+	questionId, err := g.CreateQuestion(question)
+	if err != nil {
+		return 0, err
+	}
+
+	answer := types.TriviaAnswer{
+		TriviaQuestionID: questionId,
+		IsCorrect:        true,
+		FlagCode:         country.Code,
+	}
+	err = g.CreateAnswer(answer)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, val := range wrong {
+		answer := types.TriviaAnswer{
+			TriviaQuestionID: questionId,
+			IsCorrect:        false,
+			FlagCode:         val.Code,
+		}
+
+		err = g.CreateAnswer(answer)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return 1, nil
+}
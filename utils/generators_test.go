@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+func TestCountryCapitals(t *testing.T) {
+	pairs, err := countryCapitals(newTestGeneration(t, storage.NewSeededMemoryStore()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, pair := range pairs {
+		if pair.Country.Code != pair.Capital.Code {
+			t.Errorf("expected %s and %s to share a code", pair.Country.SVGName, pair.Capital.SVGName)
+		}
+	}
+
+	for _, country := range types.TopLandmass {
+		found := false
+		for _, pair := range pairs {
+			if pair.Country.SVGName == country {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected a capital for %s", country)
+		}
+	}
+}
+
+func TestCapitalGenerators(t *testing.T) {
+	tt := []struct {
+		name      string
+		generator QuestionGeneratorFunc
+		question  func(pair countryCapital) string
+		answer    func(pair countryCapital) string
+	}{
+		{
+			name:      "what capital",
+			generator: whatCapital,
+			question: func(pair countryCapital) string {
+				return fmt.Sprintf("What is the capital city of %s?", pair.Country.SVGName)
+			},
+			answer: func(pair countryCapital) string { return pair.Capital.SVGName },
+		},
+		{
+			name:      "what capital country",
+			generator: whatCapitalCountry,
+			question: func(pair countryCapital) string {
+				return fmt.Sprintf("Which country has the capital city %s?", pair.Capital.SVGName)
+			},
+			answer: func(pair countryCapital) string { return pair.Country.SVGName },
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := storage.NewSeededMemoryStore()
+			g := newTestGeneration(t, store)
+			pairs, err := countryCapitals(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			count, err := tc.generator(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if count != 1 {
+				t.Fatalf("expected 1 question; got %d", count)
+			}
+
+			trivia, err := store.GetTrivia(context.Background(), "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			question := trivia.Questions[0]
+			var pair countryCapital
+			for _, val := range pairs {
+				if val.Capital.SVGName == question.Highlighted {
+					pair = val
+				}
+			}
+
+			if question.MapName != "WorldCapitals" || question.Question != tc.question(pair) {
+				t.Errorf("expected %q on WorldCapitals; got %q on %s", tc.question(pair), question.Question, question.MapName)
+			}
+
+			if len(question.Answers) != 4 {
+				t.Fatalf("expected 4 answers; got %d", len(question.Answers))
+			}
+
+			seen := make(map[string]bool)
+			for _, answer := range question.Answers {
+				if answer.IsCorrect != (answer.Text == tc.answer(pair)) {
+					t.Errorf("expected only %s to be correct; got %+v", tc.answer(pair), question.Answers)
+				}
+
+				if seen[answer.Text] {
+					t.Errorf("expected unique answers; got %+v", question.Answers)
+				}
+				seen[answer.Text] = true
+			}
+		})
+	}
+}
+
+func TestPickFlag(t *testing.T) {
+	tt := []struct {
+		name   string
+		groups []types.FlagSimilarityGroup
+	}{
+		{
+			name: "no similarity groups",
+		},
+		{
+			name: "similar flags",
+			groups: []types.FlagSimilarityGroup{
+				{ID: 1, Name: "crosses", Codes: []string{"a1", "a2", "a3", "a4", "a5"}},
+				{ID: 2, Name: "stripes", Codes: []string{"b1", "b2", "b3", "b4"}},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := storage.NewMemoryStore()
+			var entries []types.MappingEntryDto
+			for _, code := range []string{"a1", "a2", "a3", "a4", "a5", "b1", "b2", "b3", "b4"} {
+				entries = append(entries, types.MappingEntryDto{Code: code, SVGName: "Country " + code, FlagUrl: "https://flags/" + code + ".svg"})
+			}
+			store.AddMappingEntries("world-countries", entries)
+			for _, group := range tc.groups {
+				store.AddFlagSimilarityGroup(group)
+			}
+
+			service := NewService(store)
+			trivia := createTestTrivia(t, store, "2022-01-01", nil)
+			g := service.newGeneration(context.Background(), store, trivia.ID, trivia.Date)
+			for i := 0; i < 10; i++ {
+				if _, err := g.runGenerator("pick-flag", QuestionGeneratorFunc(pickFlag)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			trivia, err := store.GetTrivia(context.Background(), "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, question := range trivia.Questions {
+				if len(question.Answers) != 4 {
+					t.Fatalf("expected 4 answers; got %d", len(question.Answers))
+				}
+
+				correct := question.Answers[0]
+				if !correct.IsCorrect || question.Question != fmt.Sprintf("Which is the flag of Country %s?", correct.FlagCode) {
+					t.Errorf("expected the first answer to be the flag asked for; got %q with %+v", question.Question, correct)
+				}
+
+				name := strings.TrimSuffix(strings.TrimPrefix(question.Question, "Which is the flag of "), "?")
+				for _, answer := range question.Answers {
+					if strings.Contains(answer.Text, name) {
+						t.Errorf("expected answer text not to give away %s; got %q", name, answer.Text)
+					}
+				}
+
+				seen := make(map[string]bool)
+				for _, answer := range question.Answers {
+					if !answer.FlagUrl.Valid || answer.FlagUrl.String != "https://flags/"+answer.FlagCode+".svg" {
+						t.Errorf("expected a flag url for %s; got %+v", answer.FlagCode, answer.FlagUrl)
+					}
+
+					if seen[answer.FlagCode] {
+						t.Errorf("expected unique flags; got %+v", question.Answers)
+					}
+					seen[answer.FlagCode] = true
+
+					if len(tc.groups) > 0 && answer.FlagCode[0] != correct.FlagCode[0] {
+						t.Errorf("expected flags similar to %s; got %s", correct.FlagCode, answer.FlagCode)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/geobuff/generate/geometry"
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// mapGeometry is what has been worked out from the outlines of a map's elements.
+type mapGeometry struct {
+	shapes    map[string]geometry.Shape
+	adjacency geometry.Graph
+}
+
+// geometryCache holds the parsed outlines of each map and the values derived from
+// them, as parsing every outline is too slow to repeat per question. Everything
+// it returns is shared and must not be modified.
+type geometryCache struct {
+	mu   sync.Mutex
+	maps map[string]*mapGeometry
+}
+
+func newGeometryCache() *geometryCache {
+	return &geometryCache{
+		maps: make(map[string]*mapGeometry),
+	}
+}
+
+// shapes returns the outline of every element of the map keyed by element name.
+func (c *geometryCache) shapes(ctx context.Context, store storage.IStore, className string) (map[string]geometry.Shape, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, err := c.load(ctx, store, className)
+	if err != nil {
+		return nil, err
+	}
+	return m.shapes, nil
+}
+
+// adjacency returns the neighbour graph of the map, working it out on first use.
+func (c *geometryCache) adjacency(ctx context.Context, store storage.IStore, className string) (geometry.Graph, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, err := c.load(ctx, store, className)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.adjacency == nil {
+		m.adjacency = buildAdjacency(m.shapes, adjacencyOverrides[className])
+	}
+	return m.adjacency, nil
+}
+
+func (c *geometryCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maps = make(map[string]*mapGeometry)
+}
+
+// load returns the geometry of the map, reading the map from the store on first
+// use. The caller must hold the lock.
+func (c *geometryCache) load(ctx context.Context, store storage.IStore, className string) (*mapGeometry, error) {
+	if m, ok := c.maps[className]; ok {
+		return m, nil
+	}
+
+	svgMap, err := store.GetMap(ctx, className)
+	if err != nil {
+		return nil, err
+	}
+
+	shapes, err := parseShapes(svgMap)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &mapGeometry{shapes: shapes}
+	c.maps[className] = m
+	return m, nil
+}
+
+// parseShapes reads the outline of every path and polygon on the map. Elements
+// with the same name, such as a country drawn as several paths, are treated as
+// one shape.
+func parseShapes(m types.MapDto) (map[string]geometry.Shape, error) {
+	shapes := make(map[string]geometry.Shape)
+	for _, element := range m.Elements {
+		name := element.Name
+		if name == "" {
+			name = element.ID
+		}
+
+		var shape geometry.Shape
+		var err error
+		switch {
+		case element.D != "":
+			shape, err = geometry.ParsePath(element.D)
+		case element.Points != "":
+			shape, err = geometry.ParsePoints(element.Points)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid outline for %s on map %s: %w", name, m.ClassName, err)
+		}
+		shapes[name] = append(shapes[name], shape...)
+	}
+	return shapes, nil
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+func entriesNamed(names ...string) []types.MappingEntryDto {
+	var entries []types.MappingEntryDto
+	for _, name := range names {
+		entries = append(entries, types.MappingEntryDto{Code: name, SVGName: name})
+	}
+	return entries
+}
+
+// newGridStore returns a store with a mappingKey mapping group of names and a
+// className map with a path element for each of them. path returns the outline of
+// the i-th name; a nil path draws elements without an outline.
+func newGridStore(mappingKey, className string, names []string, path func(i int) string) *storage.MemoryStore {
+	store := storage.NewMemoryStore()
+
+	var elements []types.MapElementDto
+	for i, name := range names {
+		element := types.MapElementDto{Type: "path", Name: name}
+		if path != nil {
+			element.D = path(i)
+		}
+		elements = append(elements, element)
+	}
+
+	store.AddMappingEntries(mappingKey, entriesNamed(names...))
+	store.AddMap(types.MapDto{ClassName: className, Elements: elements})
+	return store
+}
+
+// square draws a square with its top left corner at x, y.
+func square(x, y, side float64) string {
+	return fmt.Sprintf("M%g %gh%gv%gh-%gZ", x, y, side, side, side)
+}
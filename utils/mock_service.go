@@ -1,17 +1,68 @@
 package utils
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+	"github.com/stretchr/testify/mock"
+)
 
 type MockService struct {
 	mock.Mock
 }
 
-func (m *MockService) CreateTrivia() error {
-	args := m.Called()
+func (m *MockService) GetTrivia(ctx context.Context, dateString string) (*types.TriviaDto, error) {
+	args := m.Called(ctx, dateString)
+	return args.Get(0).(*types.TriviaDto), args.Error(1)
+}
+
+func (m *MockService) GetAllTrivia(ctx context.Context, filter types.GetTriviaFilter) (*types.TriviaPageDto, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(*types.TriviaPageDto), args.Error(1)
+}
+
+func (m *MockService) CreateTrivia(ctx context.Context, recipe string) error {
+	args := m.Called(ctx, recipe)
 	return args.Error(0)
 }
 
-func (m *MockService) RegenerateTrivia(dateString string) error {
-	args := m.Called(dateString)
+func (m *MockService) RegenerateTrivia(ctx context.Context, dateString, recipe string, pinnedQuestionIDs []int) error {
+	args := m.Called(ctx, dateString, recipe, pinnedQuestionIDs)
 	return args.Error(0)
 }
+
+func (m *MockService) PreviewTrivia(ctx context.Context, dateString, recipe string) (*types.TriviaDto, error) {
+	args := m.Called(ctx, dateString, recipe)
+	return args.Get(0).(*types.TriviaDto), args.Error(1)
+}
+
+func (m *MockService) RegenerateTriviaQuestion(ctx context.Context, dateString string, questionID int) error {
+	args := m.Called(ctx, dateString, questionID)
+	return args.Error(0)
+}
+
+func (m *MockService) GenerateTriviaRange(ctx context.Context, from, to, recipe string, force bool) ([]types.TriviaRangeResult, error) {
+	args := m.Called(ctx, from, to, recipe, force)
+	return args.Get(0).([]types.TriviaRangeResult), args.Error(1)
+}
+
+func (m *MockService) GetCacheStats(ctx context.Context) (map[string]storage.CacheStats, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(map[string]storage.CacheStats), args.Error(1)
+}
+
+func (m *MockService) InvalidateCache(ctx context.Context, kind string) error {
+	args := m.Called(ctx, kind)
+	return args.Error(0)
+}
+
+func (m *MockService) GetAdjacency(ctx context.Context, className string) (*types.AdjacencyDto, error) {
+	args := m.Called(ctx, className)
+	return args.Get(0).(*types.AdjacencyDto), args.Error(1)
+}
+
+func (m *MockService) GetSchedulerStatus(ctx context.Context) (*types.SchedulerStatus, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*types.SchedulerStatus), args.Error(1)
+}
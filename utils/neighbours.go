@@ -0,0 +1,219 @@
+package utils
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+
+	"github.com/geobuff/generate/geometry"
+	"github.com/geobuff/generate/types"
+)
+
+// adjacencyTolerance is how close, in map units, two outlines have to come for
+// the elements to count as neighbours.
+const adjacencyTolerance = 1.0
+
+// adjacencyOverrides corrects the borders worked out from the map outlines, keyed
+// by map class name. Overseas territories drawn as part of a country add land
+// borders the outlines miss, and narrow straits can fall inside the tolerance.
+var adjacencyOverrides = map[string][]types.AdjacencyOverride{
+	"WorldCountries": {
+		{Element: "France", Neighbour: "Brazil", Adjacent: true},
+		{Element: "France", Neighbour: "Suriname", Adjacent: true},
+		{Element: "Spain", Neighbour: "Morocco", Adjacent: true},
+		{Element: "United Kingdom", Neighbour: "Ireland", Adjacent: true},
+		{Element: "Bahrain", Neighbour: "Saudi Arabia", Adjacent: false},
+		{Element: "Denmark", Neighbour: "Sweden", Adjacent: false},
+		{Element: "Djibouti", Neighbour: "Yemen", Adjacent: false},
+		{Element: "Egypt", Neighbour: "Saudi Arabia", Adjacent: false},
+		{Element: "Malaysia", Neighbour: "Singapore", Adjacent: false},
+		{Element: "Russia", Neighbour: "United States", Adjacent: false},
+		{Element: "Russia", Neighbour: "Japan", Adjacent: false},
+		{Element: "Australia", Neighbour: "Papua New Guinea", Adjacent: false},
+	},
+}
+
+// buildAdjacency works out which shapes border each other and applies the
+// overrides for the map.
+func buildAdjacency(shapes map[string]geometry.Shape, overrides []types.AdjacencyOverride) geometry.Graph {
+	graph := geometry.Adjacency(shapes, adjacencyTolerance)
+	for _, override := range overrides {
+		if _, ok := graph[override.Element]; !ok {
+			continue
+		}
+
+		if _, ok := graph[override.Neighbour]; !ok {
+			continue
+		}
+		graph.Set(override.Element, override.Neighbour, override.Adjacent)
+	}
+	return graph
+}
+
+// GetAdjacency returns the neighbour graph of a map, defaulting to WorldCountries,
+// so that the computed borders can be reviewed.
+func (s *Service) GetAdjacency(ctx context.Context, className string) (*types.AdjacencyDto, error) {
+	if className == "" {
+		className = "WorldCountries"
+	}
+
+	graph, err := s.geometry.adjacency(ctx, s.store, className)
+	if err == sql.ErrNoRows {
+		return nil, NewServiceError(ERROR_CODE_NOT_FOUND, ErrMapNotFound, map[string]interface{}{"map": className}, "map %s does not exist", className)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := adjacencyOverrides[className]
+	if overrides == nil {
+		overrides = []types.AdjacencyOverride{}
+	}
+
+	return &types.AdjacencyDto{
+		Map:        className,
+		Tolerance:  adjacencyTolerance,
+		Overrides:  overrides,
+		Neighbours: graph,
+	}, nil
+}
+
+func whichNeighbour(geometries *geometryCache) QuestionGeneratorFunc {
+	return func(g *Generation) (int, error) {
+		return neighbourQuestion(g, geometries, false)
+	}
+}
+
+func notNeighbour(geometries *geometryCache) QuestionGeneratorFunc {
+	return func(g *Generation) (int, error) {
+		return neighbourQuestion(g, geometries, true)
+	}
+}
+
+// neighbourQuestion highlights a country on the world map and asks which of the
+// answers borders it, or with not set, which of them does not. The country that
+// does not border it is taken from the neighbours of its neighbours, so that the
+// answer is not simply the one on another continent.
+func neighbourQuestion(g *Generation, geometries *geometryCache, not bool) (int, error) {
+	graph, err := geometries.adjacency(g.ctx, g.store, "WorldCountries")
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := g.MappingEntries("world-countries")
+	if err != nil {
+		return 0, err
+	}
+
+	var countries []types.MappingEntryDto
+	for _, val := range entries {
+		if _, ok := graph[val.SVGName]; ok {
+			countries = append(countries, val)
+		}
+	}
+
+	type candidate struct {
+		country    types.MappingEntryDto
+		neighbours []types.MappingEntryDto
+		nearby     []types.MappingEntryDto
+		others     []types.MappingEntryDto
+	}
+
+	var candidates []candidate
+	for _, country := range countries {
+		c := candidate{country: country}
+		for _, other := range countries {
+			if other.SVGName == country.SVGName {
+				continue
+			}
+
+			switch {
+			case graph.Adjacent(country.SVGName, other.SVGName):
+				c.neighbours = append(c.neighbours, other)
+			case sharesNeighbour(graph, country.SVGName, other.SVGName):
+				c.nearby = append(c.nearby, other)
+				c.others = append(c.others, other)
+			default:
+				c.others = append(c.others, other)
+			}
+		}
+
+		correct, incorrect := c.neighbours, c.others
+		if not {
+			correct, incorrect = c.nearby, c.neighbours
+		}
+
+		if len(correct) >= 1 && len(incorrect) >= 3 {
+			candidates = append(candidates, c)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return 0, fmt.Errorf("no country on map WorldCountries has enough neighbours for a question")
+	}
+
+	c := candidates[rand.Intn(len(candidates))]
+	text := fmt.Sprintf("Which of these countries borders %s?", c.country.SVGName)
+	correct, incorrect := c.neighbours, c.others
+	if not {
+		text = fmt.Sprintf("Which country is not a neighbour of %s?", c.country.SVGName)
+		correct, incorrect = c.nearby, c.neighbours
+	}
+
+	// The highlighted country is the target so that, for example, the nearby
+	// strategy offers countries close to it that do not border it.
+	wrong, strategy, err := g.Distractors(DistractorPool{c.country, incorrect, "WorldCountries"}, 3)
+	if err != nil {
+		return 0, err
+	}
+
+	question := types.TriviaQuestion{
+		TypeID:             types.QUESTION_TYPE_MAP,
+		Question:           text,
+		Map:                "WorldCountries",
+		Highlighted:        c.country.SVGName,
+		DistractorStrategy: strategy,
+	}
+
+	questionId, err := g.CreateQuestion(question)
+	if err != nil {
+		return 0, err
+	}
+
+	answer := types.TriviaAnswer{
+		TriviaQuestionID: questionId,
+		Text:             correct[rand.Intn(len(correct))].SVGName,
+		IsCorrect:        true,
+	}
+	err = g.CreateAnswer(answer)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, val := range wrong {
+		answer := types.TriviaAnswer{
+			TriviaQuestionID: questionId,
+			Text:             val.SVGName,
+			IsCorrect:        false,
+		}
+
+		err = g.CreateAnswer(answer)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return 1, nil
+}
+
+// sharesNeighbour reports whether a and b both border some other country.
+func sharesNeighbour(graph geometry.Graph, a, b string) bool {
+	for _, neighbour := range graph[a] {
+		if graph.Adjacent(neighbour, b) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// newNeighbourStore returns a store whose world map is a two by four grid of
+// square countries plus an island. Countries touching at a corner count as
+// neighbours.
+func newNeighbourStore() *storage.MemoryStore {
+	names := []string{"Alpha", "Bravo", "Charlie", "Delta", "Echo", "Foxtrot", "Golf", "Hotel", "India"}
+	return newGridStore("world-countries", "WorldCountries", names, func(i int) string {
+		if names[i] == "India" {
+			return "M100 100h10v10Z"
+		}
+		return square(float64(i%4*10), float64(i/4*10), 10)
+	})
+}
+
+func TestBuildAdjacency(t *testing.T) {
+	store := newNeighbourStore()
+	m, err := store.GetMap(context.Background(), "WorldCountries")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name      string
+		overrides []types.AdjacencyOverride
+		element   string
+		expected  []string
+	}{
+		{
+			name:     "from outlines",
+			element:  "Alpha",
+			expected: []string{"Bravo", "Echo", "Foxtrot"},
+		},
+		{
+			name:     "island",
+			element:  "India",
+			expected: []string{},
+		},
+		{
+			name: "overrides",
+			overrides: []types.AdjacencyOverride{
+				{Element: "Alpha", Neighbour: "India", Adjacent: true},
+				{Element: "Foxtrot", Neighbour: "Alpha", Adjacent: false},
+				{Element: "Alpha", Neighbour: "Unknown", Adjacent: true},
+			},
+			element:  "Alpha",
+			expected: []string{"Bravo", "Echo", "India"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			shapes, err := parseShapes(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			graph := buildAdjacency(shapes, tc.overrides)
+
+			if fmt.Sprint(graph[tc.element]) != fmt.Sprint(tc.expected) {
+				t.Errorf("expected neighbours %v; got %v", tc.expected, graph[tc.element])
+			}
+
+			for _, neighbour := range graph[tc.element] {
+				if !graph.Adjacent(neighbour, tc.element) {
+					t.Errorf("expected %s to list %s as a neighbour", neighbour, tc.element)
+				}
+			}
+		})
+	}
+}
+
+func TestNeighbourGenerators(t *testing.T) {
+	tt := []struct {
+		name      string
+		generator string
+		adjacent  bool
+	}{
+		{
+			name:      "which neighbour",
+			generator: "which-neighbour",
+			adjacent:  true,
+		},
+		{
+			name:      "not neighbour",
+			generator: "not-neighbour",
+			adjacent:  false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newNeighbourStore()
+			service := NewService(store)
+			generator, err := service.Generators().Get(tc.generator)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			adjacency, err := service.GetAdjacency(ctx, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			graph := adjacency.Neighbours
+
+			g := newTestGeneration(t, store)
+			for i := 0; i < 20; i++ {
+				if _, err := g.runGenerator(tc.generator, generator); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			trivia, err := store.GetTrivia(ctx, "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, question := range trivia.Questions {
+				if question.Highlighted == "India" {
+					t.Errorf("expected the island never to be asked about")
+				}
+
+				if len(question.Answers) != 4 {
+					t.Fatalf("expected 4 answers; got %d", len(question.Answers))
+				}
+
+				for _, answer := range question.Answers {
+					adjacent := containsString(graph[question.Highlighted], answer.Text)
+					if answer.IsCorrect != (adjacent == tc.adjacent) {
+						t.Errorf("%q: expected %s to be correct %v; got %v", question.Question, answer.Text, adjacent == tc.adjacent, answer.IsCorrect)
+					}
+
+					if answer.IsCorrect && !tc.adjacent && !sharesNeighbour(graph, question.Highlighted, answer.Text) {
+						t.Errorf("%q: expected %s to border a neighbour of %s", question.Question, answer.Text, question.Highlighted)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGetAdjacency(t *testing.T) {
+	ctx := context.Background()
+	memoryStore := newNeighbourStore()
+	service := NewService(storage.NewCachedStore(memoryStore, storage.DefaultCacheTTLs))
+
+	if _, err := service.GetAdjacency(ctx, "UsStates"); !errors.Is(err, ErrMapNotFound) {
+		t.Fatalf("expected error %v; got %v", ErrMapNotFound, err)
+	}
+
+	adjacency, err := service.GetAdjacency(ctx, "WorldCountries")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(adjacency.Neighbours) != 9 || len(adjacency.Overrides) != len(adjacencyOverrides["WorldCountries"]) {
+		t.Fatalf("expected 9 countries and the world overrides; got %+v", adjacency)
+	}
+
+	memoryStore.AddMap(types.MapDto{ClassName: "WorldCountries"})
+	if adjacency, _ = service.GetAdjacency(ctx, "WorldCountries"); len(adjacency.Neighbours) != 9 {
+		t.Errorf("expected the graph to stay cached; got %+v", adjacency.Neighbours)
+	}
+
+	if err := service.InvalidateCache(ctx, storage.CACHE_KIND_MAPS); err != nil {
+		t.Fatal(err)
+	}
+
+	if adjacency, _ = service.GetAdjacency(ctx, "WorldCountries"); len(adjacency.Neighbours) != 0 {
+		t.Errorf("expected the graph to be rebuilt after invalidating maps; got %+v", adjacency.Neighbours)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, val := range values {
+		if val == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// maxTriviaRangeDays is the longest span of dates a single range call may cover.
+const maxTriviaRangeDays = 366
+
+// GenerateTriviaRange generates the trivia for every date from from to to
+// inclusive, in date order so that the manual question cooldown carries across
+// the span. Existing trivia are skipped unless force is set, in which case they
+// are regenerated. Each date is generated in its own transaction and the result
+// of every date is returned, including the ones that failed.
+func (s *Service) GenerateTriviaRange(ctx context.Context, from, to, recipeName string, force bool) ([]types.TriviaRangeResult, error) {
+	if from == "" || to == "" {
+		return nil, validationError(map[string]interface{}{"from": from, "to": to}, "both from and to are required")
+	}
+
+	fromDate, toDate, err := s.parseDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if days := int(toDate.Sub(fromDate).Hours()/24) + 1; days > maxTriviaRangeDays {
+		return nil, validationError(map[string]interface{}{"from": from, "to": to}, "range cannot cover more than %d days", maxTriviaRangeDays)
+	}
+
+	recipe, err := s.getRecipe(recipeName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []types.TriviaRangeResult{}
+	for date := fromDate; !date.After(toDate); date = date.AddDate(0, 0, 1) {
+		status, err := s.generateTriviaInRange(ctx, date, recipe, force)
+		result := types.TriviaRangeResult{
+			Date:   date.Format("2006-01-02"),
+			Status: status,
+		}
+
+		if err != nil {
+			result.Status = types.TRIVIA_RANGE_FAILED
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (s *Service) generateTriviaInRange(ctx context.Context, date time.Time, recipe types.Recipe, force bool) (string, error) {
+	status := types.TRIVIA_RANGE_CREATED
+	err := s.withDateLock(ctx, date, func(ctx context.Context, store storage.IStore) error {
+		if force {
+			doesNotExist, err := store.TriviaDoesNotExistForDate(ctx, date)
+			if err != nil && err != sql.ErrNoRows {
+				return err
+			}
+
+			if !doesNotExist {
+				status = types.TRIVIA_RANGE_REGENERATED
+				if err := deleteTriviaForDate(ctx, store, date.Format("2006-01-02")); err != nil {
+					return err
+				}
+			}
+		}
+
+		return s.createTriviaForDate(ctx, store, date, recipe)
+	})
+
+	if errors.Is(err, ErrTriviaExists) {
+		return types.TRIVIA_RANGE_SKIPPED, nil
+	}
+	return status, err
+}
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+func TestGenerateTriviaRange(t *testing.T) {
+	tt := []struct {
+		name     string
+		from     string
+		to       string
+		force    bool
+		expected []types.TriviaRangeResult
+		err      error
+	}{
+		{
+			name: "invalid date",
+			from: "2022-01-32",
+			to:   "2022-02-01",
+			err:  ErrInvalidInput,
+		},
+		{
+			name: "from after to",
+			from: "2022-01-03",
+			to:   "2022-01-01",
+			err:  ErrInvalidInput,
+		},
+		{
+			name: "range too long",
+			from: "2022-01-01",
+			to:   "2023-01-02",
+			err:  ErrInvalidInput,
+		},
+		{
+			name: "skips existing dates",
+			from: "2022-01-01",
+			to:   "2022-01-03",
+			expected: []types.TriviaRangeResult{
+				{Date: "2022-01-01", Status: types.TRIVIA_RANGE_CREATED},
+				{Date: "2022-01-02", Status: types.TRIVIA_RANGE_SKIPPED},
+				{Date: "2022-01-03", Status: types.TRIVIA_RANGE_CREATED},
+			},
+		},
+		{
+			name:  "regenerates existing dates when forced",
+			from:  "2022-01-01",
+			to:    "2022-01-03",
+			force: true,
+			expected: []types.TriviaRangeResult{
+				{Date: "2022-01-01", Status: types.TRIVIA_RANGE_CREATED},
+				{Date: "2022-01-02", Status: types.TRIVIA_RANGE_REGENERATED},
+				{Date: "2022-01-03", Status: types.TRIVIA_RANGE_CREATED},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := storage.NewSeededMemoryStore()
+			service := NewService(store)
+			existing := createTestTrivia(t, store, "2022-01-02", nil)
+
+			results, err := service.GenerateTriviaRange(ctx, tc.from, tc.to, "", tc.force)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v; got %v", tc.err, err)
+			}
+
+			if len(results) != len(tc.expected) {
+				t.Fatalf("expected results %+v; got %+v", tc.expected, results)
+			}
+
+			for i, expected := range tc.expected {
+				if results[i] != expected {
+					t.Errorf("expected results %+v; got %+v", tc.expected, results)
+				}
+
+				trivia, err := store.GetTrivia(ctx, expected.Date)
+				if err != nil {
+					t.Fatalf("expected trivia for %s; got %v", expected.Date, err)
+				}
+
+				replaced := trivia.ID != existing.ID
+				if expected.Date == "2022-01-02" && replaced != tc.force {
+					t.Errorf("expected trivia for %s to be replaced %v; got %v", expected.Date, tc.force, replaced)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateTriviaRangeManualQuestionCooldown(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	service := NewService(store)
+	service.AddRecipe(types.Recipe{Name: "text", QuestionCount: 1, Slots: []types.RecipeSlot{{Manual: types.MANUAL_SLOT_TEXT}}})
+	store.AddTriviaQuestionCategory(types.TriviaQuestionCategory{ID: 1, Name: "General", IsActive: true})
+	for i := 0; i < 2; i++ {
+		store.AddManualTriviaQuestion(types.ManualTriviaQuestion{TypeID: types.QUESTION_TYPE_TEXT, CategoryID: 1}, []types.ManualTriviaAnswer{{Text: "Yes", IsCorrect: true}})
+	}
+
+	results, err := service.GenerateTriviaRange(ctx, "2022-01-01", "2022-01-03", "text", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two questions can only fill two days of a three day span when each question
+	// is held back for a week after it is used.
+	var maxScores []int
+	for _, result := range results {
+		trivia, err := store.GetTrivia(ctx, result.Date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		maxScores = append(maxScores, trivia.MaxScore)
+	}
+
+	if maxScores[0] != 1 || maxScores[1] != 1 || maxScores[2] != 0 {
+		t.Errorf("expected a question on the first two days only; got max scores %v", maxScores)
+	}
+
+	if _, err := service.GenerateTriviaRange(ctx, "2022-01-10", "2022-01-10", "text", false); err != nil {
+		t.Fatal(err)
+	}
+
+	trivia, err := store.GetTrivia(ctx, "2022-01-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if trivia.MaxScore != 1 {
+		t.Errorf("expected a question to be available again after the cooldown; got max score %d", trivia.MaxScore)
+	}
+}
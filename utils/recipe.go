@@ -0,0 +1,268 @@
+package utils
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/geobuff/generate/types"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultRecipe is the daily quiz format used when a request does not name a recipe:
+// one of each built-in map and flag question, any manual questions scheduled for the
+// day and then an even split of text and image questions from random categories.
+var DefaultRecipe = types.Recipe{
+	Name:          "default",
+	QuestionCount: 10,
+	Slots: []types.RecipeSlot{
+		{Generator: "what-country", Count: 1},
+		{Generator: "what-capital", Count: 1},
+		{Generator: "what-us-state", Count: 1},
+		{Generator: "what-flag", Count: 1},
+		{Manual: types.MANUAL_SLOT_SCHEDULED},
+		{Manual: types.MANUAL_SLOT_TEXT, Share: 0.5},
+		{Manual: types.MANUAL_SLOT_IMAGE},
+	},
+}
+
+// LoadRecipes reads every .json, .yaml and .yml file in dir as a recipe.
+func LoadRecipes(dir string) ([]types.Recipe, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipes []types.Recipe
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		var unmarshal func([]byte, interface{}) error
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".json":
+			unmarshal = json.Unmarshal
+		case ".yaml", ".yml":
+			unmarshal = yaml.Unmarshal
+		default:
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var recipe types.Recipe
+		if err := unmarshal(data, &recipe); err != nil {
+			return nil, fmt.Errorf("invalid recipe %s: %v", path, err)
+		}
+		recipes = append(recipes, recipe)
+	}
+
+	return recipes, nil
+}
+
+// AddRecipe validates a recipe and makes it selectable by name, replacing any
+// existing recipe with the same name.
+func (s *Service) AddRecipe(recipe types.Recipe) error {
+	if err := s.validateRecipe(recipe); err != nil {
+		return fmt.Errorf("invalid recipe %s: %v", recipe.Name, err)
+	}
+
+	s.recipes[recipe.Name] = recipe
+	return nil
+}
+
+func (s *Service) getRecipe(name string) (types.Recipe, error) {
+	if name == "" {
+		name = DefaultRecipe.Name
+	}
+
+	recipe, ok := s.recipes[name]
+	if !ok {
+		return types.Recipe{}, validationError(map[string]interface{}{"recipe": name}, "recipe %s does not exist", name)
+	}
+	return recipe, nil
+}
+
+func (s *Service) validateRecipe(recipe types.Recipe) error {
+	if recipe.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if recipe.QuestionCount <= 0 {
+		return fmt.Errorf("questionCount must be greater than zero")
+	}
+
+	if len(recipe.Slots) == 0 {
+		return fmt.Errorf("at least one slot is required")
+	}
+
+	for i, slot := range recipe.Slots {
+		if (slot.Generator == "") == (slot.Manual == "") {
+			return fmt.Errorf("slot %d must set exactly one of generator or manual", i)
+		}
+
+		if slot.Count < 0 {
+			return fmt.Errorf("slot %d count cannot be negative", i)
+		}
+
+		if slot.Share < 0 || slot.Share > 1 {
+			return fmt.Errorf("slot %d share must be between 0 and 1", i)
+		}
+
+		if slot.Generator != "" {
+			if _, err := s.generators.Get(slot.Generator); err != nil {
+				return fmt.Errorf("slot %d: %v", i, err)
+			}
+			continue
+		}
+
+		switch slot.Manual {
+		case types.MANUAL_SLOT_SCHEDULED, types.MANUAL_SLOT_TEXT, types.MANUAL_SLOT_IMAGE:
+		default:
+			return fmt.Errorf("slot %d has unknown manual type %s", i, slot.Manual)
+		}
+	}
+
+	return nil
+}
+
+// slotQuantity works out how many questions a slot asks for given the number of
+// questions still needed. A slot with neither a count nor a share fills the rest.
+func slotQuantity(slot types.RecipeSlot, remaining int) int {
+	quantity := remaining
+	if slot.Count > 0 {
+		quantity = slot.Count
+	} else if slot.Share > 0 {
+		quantity = int(float64(remaining) * slot.Share)
+	}
+
+	if quantity > remaining {
+		return remaining
+	}
+	return quantity
+}
+
+func (s *Service) fillGeneratorSlot(g *Generation, slot types.RecipeSlot, remaining int) (int, error) {
+	generator, err := s.generators.Get(slot.Generator)
+	if err != nil {
+		return 0, err
+	}
+
+	quantity := slotQuantity(slot, remaining)
+
+	count := 0
+	filled := 0
+	for filled < quantity {
+		if g.usePinned(slot.Generator) {
+			filled = filled + 1
+			continue
+		}
+
+		generated, err := g.runGenerator(slot.Generator, generator)
+		if err != nil {
+			return count, err
+		}
+
+		if generated == 0 {
+			break
+		}
+		count = count + generated
+		filled = filled + generated
+	}
+
+	return count, nil
+}
+
+func (s *Service) fillManualSlot(g *Generation, slot types.RecipeSlot, remaining int) (int, error) {
+	quantity := slotQuantity(slot, remaining)
+	if quantity == 0 {
+		return 0, nil
+	}
+
+	if slot.Manual == types.MANUAL_SLOT_SCHEDULED {
+		questions, err := g.store.GetManualTriviaQuestionsForDate(g.ctx, g.Date.Format("2006-01-02"))
+		if err != nil && err != sql.ErrNoRows {
+			return 0, err
+		}
+		return s.createQuestionsAndAnswers(g, questions, quantity)
+	}
+
+	categories, err := g.availableCategories(slot)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(categories) < quantity {
+		if slot.Count == 0 && slot.Share == 0 {
+			return 0, fmt.Errorf("need to generate %d more questions but only %d available categories", quantity, len(categories))
+		}
+		quantity = len(categories)
+	}
+
+	var allowedCategories []int
+	for i := 0; i < quantity; i++ {
+		index := rand.Intn(len(categories))
+		allowedCategories = append(allowedCategories, categories[index].ID)
+		categories = append(categories[:index], categories[index+1:]...)
+	}
+
+	typeID := types.QUESTION_TYPE_TEXT
+	if slot.Manual == types.MANUAL_SLOT_IMAGE {
+		typeID = types.QUESTION_TYPE_IMAGE
+	}
+
+	count, err := s.setRandomManualTriviaQuestions(g, typeID, quantity, allowedCategories)
+	if err != nil && err != sql.ErrNoRows {
+		return count, err
+	}
+	return count, nil
+}
+
+// availableCategories returns the active categories that match the slot filters
+// and that no question of the same trivia has been taken from yet.
+func (g *Generation) availableCategories(slot types.RecipeSlot) ([]types.TriviaQuestionCategory, error) {
+	if g.categories == nil {
+		categories, err := g.store.GetTriviaQuestionCategories(g.ctx, true)
+		if err != nil {
+			return nil, err
+		}
+		g.categories = categories
+	}
+
+	var result []types.TriviaQuestionCategory
+	for _, category := range g.categories {
+		if g.usedCategories[category.ID] {
+			continue
+		}
+
+		if slot.Manual == types.MANUAL_SLOT_TEXT && category.ImageOnly {
+			continue
+		}
+
+		if len(slot.Categories) > 0 && !containsFold(slot.Categories, category.Name) {
+			continue
+		}
+
+		result = append(result, category)
+	}
+
+	return result, nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, val := range values {
+		if strings.EqualFold(val, value) {
+			return true
+		}
+	}
+	return false
+}
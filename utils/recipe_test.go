@@ -0,0 +1,251 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+func TestAddRecipe(t *testing.T) {
+	tt := []struct {
+		name     string
+		recipe   types.Recipe
+		expected string
+	}{
+		{
+			name:     "missing name",
+			recipe:   types.Recipe{QuestionCount: 1, Slots: []types.RecipeSlot{{Generator: "what-flag"}}},
+			expected: "invalid recipe : name cannot be empty",
+		},
+		{
+			name:     "no slots",
+			recipe:   types.Recipe{Name: "test", QuestionCount: 1},
+			expected: "invalid recipe test: at least one slot is required",
+		},
+		{
+			name:     "generator and manual",
+			recipe:   types.Recipe{Name: "test", QuestionCount: 1, Slots: []types.RecipeSlot{{Generator: "what-flag", Manual: types.MANUAL_SLOT_TEXT}}},
+			expected: "invalid recipe test: slot 0 must set exactly one of generator or manual",
+		},
+		{
+			name:     "unknown generator",
+			recipe:   types.Recipe{Name: "test", QuestionCount: 1, Slots: []types.RecipeSlot{{Generator: "unknown"}}},
+			expected: "invalid recipe test: slot 0: question generator unknown is not registered",
+		},
+		{
+			name:     "unknown manual type",
+			recipe:   types.Recipe{Name: "test", QuestionCount: 1, Slots: []types.RecipeSlot{{Manual: "video"}}},
+			expected: "invalid recipe test: slot 0 has unknown manual type video",
+		},
+		{
+			name:     "happy path",
+			recipe:   types.Recipe{Name: "test", QuestionCount: 2, Slots: []types.RecipeSlot{{Generator: "what-flag", Count: 2}}},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewService(storage.NewSeededMemoryStore())
+			err := service.AddRecipe(tc.recipe)
+
+			if tc.expected == "" && err != nil {
+				t.Fatalf("expected no error; got %v", err)
+			}
+
+			if tc.expected != "" && (err == nil || err.Error() != tc.expected) {
+				t.Fatalf("expected error %q; got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestLoadRecipes(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"flags.json": `{"name": "flags", "questionCount": 3, "slots": [{"generator": "what-flag", "count": 3}]}`,
+		"maps.yaml":  "name: maps\nquestionCount: 2\nslots:\n  - generator: what-country\n    share: 0.5\n  - generator: what-us-state\n",
+		"notes.txt":  "not a recipe",
+	}
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	recipes, err := LoadRecipes(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recipes) != 2 || recipes[0].Name != "flags" || recipes[0].Slots[0].Count != 3 {
+		t.Fatalf("unexpected recipes %+v", recipes)
+	}
+
+	if recipes[1].Name != "maps" || recipes[1].QuestionCount != 2 || len(recipes[1].Slots) != 2 || recipes[1].Slots[0].Share != 0.5 {
+		t.Fatalf("unexpected yaml recipe %+v", recipes[1])
+	}
+
+	service := NewService(storage.NewSeededMemoryStore())
+	if err := service.AddRecipe(recipes[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := service.generateQuestions(newTestGeneration(t, service.store), recipes[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 3 {
+		t.Errorf("expected 3 questions; got %d", count)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "broken.yml"), []byte("name: [flags"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadRecipes(dir); err == nil {
+		t.Error("expected an error for an invalid yaml recipe; got nil")
+	}
+}
+
+func TestGeneratorSlotQuantity(t *testing.T) {
+	tt := []struct {
+		name     string
+		slots    []types.RecipeSlot
+		expected map[string]int
+	}{
+		{
+			name:     "count",
+			slots:    []types.RecipeSlot{{Generator: "first", Count: 3}, {Generator: "second"}},
+			expected: map[string]int{"first": 3, "second": 5},
+		},
+		{
+			name:     "share",
+			slots:    []types.RecipeSlot{{Generator: "first", Share: 0.5}, {Generator: "second"}},
+			expected: map[string]int{"first": 4, "second": 4},
+		},
+		{
+			name:     "fills the rest",
+			slots:    []types.RecipeSlot{{Generator: "first"}, {Generator: "second"}},
+			expected: map[string]int{"first": 8},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewService(storage.NewSeededMemoryStore())
+			counts := make(map[string]int)
+			for _, name := range []string{"first", "second"} {
+				name := name
+				service.Generators().Register(name, QuestionGeneratorFunc(func(g *Generation) (int, error) {
+					counts[name]++
+					return 1, nil
+				}))
+			}
+
+			recipe := types.Recipe{Name: "test", QuestionCount: 8, Slots: tc.slots}
+			if err := service.AddRecipe(recipe); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := service.generateQuestions(newTestGeneration(t, service.store), recipe); err != nil {
+				t.Fatal(err)
+			}
+
+			if fmt.Sprint(counts) != fmt.Sprint(tc.expected) {
+				t.Errorf("expected %v; got %v", tc.expected, counts)
+			}
+		})
+	}
+}
+
+func TestSlotQuantity(t *testing.T) {
+	tt := []struct {
+		name      string
+		slot      types.RecipeSlot
+		remaining int
+		expected  int
+	}{
+		{
+			name:      "fill remaining",
+			slot:      types.RecipeSlot{Manual: types.MANUAL_SLOT_IMAGE},
+			remaining: 5,
+			expected:  5,
+		},
+		{
+			name:      "share of remaining",
+			slot:      types.RecipeSlot{Manual: types.MANUAL_SLOT_TEXT, Share: 0.5},
+			remaining: 5,
+			expected:  2,
+		},
+		{
+			name:      "count capped by remaining",
+			slot:      types.RecipeSlot{Manual: types.MANUAL_SLOT_TEXT, Count: 8},
+			remaining: 5,
+			expected:  5,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result := slotQuantity(tc.slot, tc.remaining)
+			if result != tc.expected {
+				t.Errorf("expected %d; got %d", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestManualSlotOutOfCategories(t *testing.T) {
+	tt := []struct {
+		name     string
+		slots    []types.RecipeSlot
+		expected string
+	}{
+		{
+			name:     "fills the rest",
+			slots:    []types.RecipeSlot{{Manual: types.MANUAL_SLOT_TEXT}},
+			expected: "need to generate 20 more questions but only 3 available categories",
+		},
+		{
+			name:  "share falls through to the rest",
+			slots: []types.RecipeSlot{{Manual: types.MANUAL_SLOT_TEXT, Share: 1}, {Generator: "rest"}},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := storage.NewMemoryStore()
+			for id := 1; id <= 3; id++ {
+				store.AddTriviaQuestionCategory(types.TriviaQuestionCategory{ID: id, Name: fmt.Sprint(id), IsActive: true})
+			}
+
+			service := NewService(store)
+			service.Generators().Register("rest", QuestionGeneratorFunc(func(g *Generation) (int, error) {
+				return 1, nil
+			}))
+
+			recipe := types.Recipe{Name: "test", QuestionCount: 20, Slots: tc.slots}
+			count, err := service.generateQuestions(newTestGeneration(t, service.store), recipe)
+			if tc.expected != "" {
+				if err == nil || err.Error() != tc.expected {
+					t.Errorf("expected error %q; got %v", tc.expected, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if count != recipe.QuestionCount {
+				t.Errorf("expected %d questions; got %d", recipe.QuestionCount, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package utils
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+var whatCountry = types.HighlightedRegion{
+	Name:       "what-country",
+	MappingKey: "world-countries",
+	Map:        "WorldCountries",
+	Noun:       "country",
+	Targets:    types.TopLandmass,
+}
+
+var whatUSState = types.HighlightedRegion{
+	Name:       "what-us-state",
+	MappingKey: "us-states",
+	Map:        "UsStates",
+	Noun:       "US state",
+}
+
+// defaultHighlightedRegions are registered with every service.
+var defaultHighlightedRegions = []types.HighlightedRegion{whatCountry, whatUSState}
+
+// LoadHighlightedRegions reads a JSON array of highlighted region generators from path.
+func LoadHighlightedRegions(path string) ([]types.HighlightedRegion, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []types.HighlightedRegion
+	if err := json.Unmarshal(data, &regions); err != nil {
+		return nil, fmt.Errorf("invalid highlighted regions %s: %v", path, err)
+	}
+	return regions, nil
+}
+
+// AddHighlightedRegion checks the region against the store and registers a
+// generator for it under its name.
+func (s *Service) AddHighlightedRegion(ctx context.Context, region types.HighlightedRegion) error {
+	if err := validateHighlightedRegion(ctx, s.store, region); err != nil {
+		return fmt.Errorf("invalid highlighted region %s: %v", region.Name, err)
+	}
+	return s.generators.Register(region.Name, highlightedRegion(region))
+}
+
+// defaultHighlightedRegion returns the generator for a built-in region. Built-in
+// regions are not checked when the service starts, as the store may not be
+// reachable yet, so they are checked the first time they generate instead.
+func defaultHighlightedRegion(region types.HighlightedRegion) QuestionGenerator {
+	return newCheckedGenerator(func(g *Generation) error {
+		if err := validateHighlightedRegion(g.ctx, g.store, region); err != nil {
+			return fmt.Errorf("invalid highlighted region %s: %v", region.Name, err)
+		}
+		return nil
+	}, highlightedRegion(region))
+}
+
+func validateHighlightedRegion(ctx context.Context, store storage.IStore, region types.HighlightedRegion) error {
+	if region.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if region.MappingKey == "" || region.Map == "" || region.Noun == "" {
+		return fmt.Errorf("mappingKey, map and noun are required")
+	}
+
+	entries, err := validateMapping(ctx, store, region.MappingKey, region.Map)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range region.Targets {
+		if indexOfEntry(entries, target) < 0 {
+			return fmt.Errorf("target %s is not in mapping group %s", target, region.MappingKey)
+		}
+	}
+
+	return nil
+}
+
+// validateMapping checks that the mapping group has enough entries to ask about
+// and that mapName draws an element for every one of them.
+func validateMapping(ctx context.Context, store storage.IStore, mappingKey, mapName string) ([]types.MappingEntryDto, error) {
+	entries, err := store.GetMappingEntries(ctx, mappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) < 4 {
+		return nil, fmt.Errorf("mapping group %s needs at least 4 entries", mappingKey)
+	}
+
+	svgMap, err := store.GetMap(ctx, mapName)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("map %s does not exist", mapName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make(map[string]bool)
+	for _, element := range svgMap.Elements {
+		name := element.Name
+		if name == "" {
+			name = element.ID
+		}
+		elements[name] = true
+	}
+
+	var missing []string
+	for _, entry := range entries {
+		if !elements[entry.SVGName] {
+			missing = append(missing, entry.SVGName)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("map %s has no element for %s", mapName, strings.Join(missing, ", "))
+	}
+
+	return entries, nil
+}
+
+// highlightedRegion returns a generator that highlights an element of the
+// region's map and asks which one it is, with three other entries of the mapping
+// group picked by the configured distractor strategy as wrong answers.
+func highlightedRegion(region types.HighlightedRegion) QuestionGeneratorFunc {
+	return func(g *Generation) (int, error) {
+		entries, err := g.MappingEntries(region.MappingKey)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(entries) < 4 {
+			return 0, fmt.Errorf("mapping group %s needs at least 4 entries", region.MappingKey)
+		}
+
+		index := rand.Intn(len(entries))
+		if len(region.Targets) > 0 {
+			target := region.Targets[rand.Intn(len(region.Targets))]
+			index = indexOfEntry(entries, target)
+			if index < 0 {
+				return 0, fmt.Errorf("unable to find %s in %s mappings", target, region.MappingKey)
+			}
+		}
+
+		target := entries[index]
+		entries = append(entries[:index], entries[index+1:]...)
+
+		wrong, strategy, err := g.Distractors(DistractorPool{target, entries, region.Map}, 3)
+		if err != nil {
+			return 0, err
+		}
+
+		question := types.TriviaQuestion{
+			TypeID:             types.QUESTION_TYPE_MAP,
+			Question:           fmt.Sprintf("Which %s is highlighted above?", region.Noun),
+			Map:                region.Map,
+			Highlighted:        target.SVGName,
+			DistractorStrategy: strategy,
+		}
+
+		questionId, err := g.CreateQuestion(question)
+		if err != nil {
+			return 0, err
+		}
+
+		answer := types.TriviaAnswer{
+			TriviaQuestionID: questionId,
+			Text:             target.SVGName,
+			IsCorrect:        true,
+		}
+
+		err = g.CreateAnswer(answer)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, val := range wrong {
+			answer := types.TriviaAnswer{
+				TriviaQuestionID: questionId,
+				Text:             val.SVGName,
+				IsCorrect:        false,
+			}
+
+			err = g.CreateAnswer(answer)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		return 1, nil
+	}
+}
+
+func indexOfEntry(entries []types.MappingEntryDto, svgName string) int {
+	for i, val := range entries {
+		if val.SVGName == svgName {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+var provinces = []string{"Alberta", "British Columbia", "Manitoba", "Ontario", "Quebec"}
+
+// newProvinceStore returns a store with a canadian-provinces mapping group and a
+// CanadaProvinces map drawing every province.
+func newProvinceStore() *storage.MemoryStore {
+	return newGridStore("canadian-provinces", "CanadaProvinces", provinces, nil)
+}
+
+func TestAddHighlightedRegion(t *testing.T) {
+	region := types.HighlightedRegion{Name: "what-province", MappingKey: "canadian-provinces", Map: "CanadaProvinces", Noun: "province"}
+
+	tt := []struct {
+		name     string
+		region   func() types.HighlightedRegion
+		unmapped []string
+		expected string
+	}{
+		{
+			name:     "missing name",
+			region:   func() types.HighlightedRegion { r := region; r.Name = ""; return r },
+			expected: "invalid highlighted region : name cannot be empty",
+		},
+		{
+			name:     "missing noun",
+			region:   func() types.HighlightedRegion { r := region; r.Noun = ""; return r },
+			expected: "invalid highlighted region what-province: mappingKey, map and noun are required",
+		},
+		{
+			name:     "unknown mapping group",
+			region:   func() types.HighlightedRegion { r := region; r.MappingKey = "unknown"; return r },
+			expected: "invalid highlighted region what-province: mapping group unknown needs at least 4 entries",
+		},
+		{
+			name:     "unknown map",
+			region:   func() types.HighlightedRegion { r := region; r.Map = "Unknown"; return r },
+			expected: "invalid highlighted region what-province: map Unknown does not exist",
+		},
+		{
+			name:     "missing elements",
+			region:   func() types.HighlightedRegion { return region },
+			unmapped: []string{"Nunavut", "Yukon"},
+			expected: "invalid highlighted region what-province: map CanadaProvinces has no element for Nunavut, Yukon",
+		},
+		{
+			name:     "unknown target",
+			region:   func() types.HighlightedRegion { r := region; r.Targets = []string{"Ontario", "Yukon"}; return r },
+			expected: "invalid highlighted region what-province: target Yukon is not in mapping group canadian-provinces",
+		},
+		{
+			name:     "already registered",
+			region:   func() types.HighlightedRegion { r := region; r.Name = "what-country"; return r },
+			expected: "question generator what-country is already registered",
+		},
+		{
+			name:     "happy path",
+			region:   func() types.HighlightedRegion { return region },
+			expected: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newProvinceStore()
+			store.AddMappingEntries("canadian-provinces", entriesNamed(tc.unmapped...))
+
+			service := NewService(store)
+			err := service.AddHighlightedRegion(context.Background(), tc.region())
+			if tc.expected == "" {
+				if err != nil {
+					t.Fatalf("expected nil error; got %v", err)
+				}
+
+				if _, err := service.Generators().Get("what-province"); err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("expected error %q; got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestHighlightedRegion(t *testing.T) {
+	tt := []struct {
+		name    string
+		targets []string
+	}{
+		{
+			name: "any entry",
+		},
+		{
+			name:    "targets",
+			targets: []string{"Quebec"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newProvinceStore()
+			region := types.HighlightedRegion{Name: "what-province", MappingKey: "canadian-provinces", Map: "CanadaProvinces", Noun: "province", Targets: tc.targets}
+
+			g := newTestGeneration(t, store)
+			count, err := g.runGenerator(region.Name, highlightedRegion(region))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if count != 1 {
+				t.Fatalf("expected 1 question; got %d", count)
+			}
+
+			trivia, err := store.GetTrivia(context.Background(), "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			question := trivia.Questions[0]
+			if question.Question != "Which province is highlighted above?" || question.MapName != "CanadaProvinces" {
+				t.Errorf("unexpected question %+v", question)
+			}
+
+			if len(question.Answers) != 4 {
+				t.Fatalf("expected 4 answers; got %d", len(question.Answers))
+			}
+
+			seen := make(map[string]bool)
+			for _, answer := range question.Answers {
+				if seen[answer.Text] {
+					t.Errorf("answer %s repeated", answer.Text)
+				}
+				seen[answer.Text] = true
+
+				if answer.IsCorrect != (answer.Text == question.Highlighted) {
+					t.Errorf("answer %s has isCorrect %v with %s highlighted", answer.Text, answer.IsCorrect, question.Highlighted)
+				}
+			}
+
+			if len(tc.targets) > 0 && question.Highlighted != tc.targets[0] {
+				t.Errorf("expected %s highlighted; got %s", tc.targets[0], question.Highlighted)
+			}
+		})
+	}
+}
+
+func TestLoadHighlightedRegions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "regions.json")
+	regions := `[{"name": "what-province", "mappingKey": "canadian-provinces", "map": "CanadaProvinces", "noun": "province", "targets": ["Ontario"]}]`
+	if err := os.WriteFile(path, []byte(regions), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := LoadHighlightedRegions(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != 1 || result[0].MappingKey != "canadian-provinces" || result[0].Targets[0] != "Ontario" {
+		t.Fatalf("unexpected regions %+v", result)
+	}
+
+	service := NewService(newProvinceStore())
+	if err := service.AddHighlightedRegion(context.Background(), result[0]); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDefaultHighlightedRegions(t *testing.T) {
+	for _, region := range defaultHighlightedRegions {
+		t.Run(region.Name, func(t *testing.T) {
+			if err := validateHighlightedRegion(context.Background(), storage.NewSeededMemoryStore(), region); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDefaultHighlightedRegionChecksStore(t *testing.T) {
+	store := storage.NewMemoryStore()
+	service := NewService(store)
+	generator, err := service.Generators().Get("what-us-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "invalid highlighted region what-us-state: mapping group us-states needs at least 4 entries"
+	if _, err := generator.Generate(newTestGeneration(t, store)); err == nil || err.Error() != expected {
+		t.Errorf("expected error %q; got %v", expected, err)
+	}
+}
@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+// defaultSchedulerInterval is used in place of an interval that is not positive,
+// which would otherwise have the scheduler check the store in a busy loop.
+const defaultSchedulerInterval = time.Hour
+
+// SchedulerConfig controls the background generation of upcoming trivia.
+type SchedulerConfig struct {
+	// LookaheadDays is how many days after today should always have a trivia.
+	// Today is included as well so that a missed day is still filled in.
+	LookaheadDays int
+	// Interval is how long to wait between checks for missing dates. Zero or less
+	// means defaultSchedulerInterval.
+	Interval time.Duration
+	// MaxRetries is how many more times a failed date is attempted in a run.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry. It doubles on each retry.
+	RetryBackoff time.Duration
+	// Recipe is the recipe used to generate missing trivia.
+	Recipe string
+}
+
+// Scheduler keeps the trivia for today and the next LookaheadDays generated, with
+// today taken from the service clock.
+type Scheduler struct {
+	service *Service
+	config  SchedulerConfig
+	now     func() time.Time
+	mu      sync.Mutex
+	status  types.SchedulerStatus
+}
+
+func newScheduler(service *Service, config SchedulerConfig) *Scheduler {
+	if config.Interval <= 0 {
+		config.Interval = defaultSchedulerInterval
+	}
+
+	return &Scheduler{
+		service: service,
+		config:  config,
+		now:     service.clock.Now,
+		status: types.SchedulerStatus{
+			LookaheadDays: config.LookaheadDays,
+		},
+	}
+}
+
+// StartScheduler generates missing upcoming trivia in the background until ctx
+// is cancelled.
+func (s *Service) StartScheduler(ctx context.Context, config SchedulerConfig) {
+	s.scheduler = newScheduler(s, config)
+	go s.scheduler.run(ctx)
+}
+
+func (s *Service) GetSchedulerStatus(ctx context.Context) (*types.SchedulerStatus, error) {
+	if s.scheduler == nil {
+		return nil, NewServiceError(ERROR_CODE_NOT_FOUND, ErrSchedulerDisabled, nil, "trivia scheduler is not enabled")
+	}
+	return s.scheduler.Status(), nil
+}
+
+func (s *Scheduler) Status() *types.SchedulerStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.status
+	status.MissingDates = append([]string{}, s.status.MissingDates...)
+	status.GeneratedDates = append([]string{}, s.status.GeneratedDates...)
+	status.Failures = append([]types.SchedulerFailure{}, s.status.Failures...)
+	return &status
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	s.setRunning(true)
+	defer s.setRunning(false)
+
+	for {
+		s.runOnce(ctx)
+
+		next := s.now().Add(s.config.Interval)
+		s.mu.Lock()
+		s.status.NextRun = &next
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.config.Interval):
+		}
+	}
+}
+
+func (s *Scheduler) setRunning(running bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status.Running = running
+	if !running {
+		s.status.NextRun = nil
+	}
+}
+
+// runOnce generates the trivia for every date in the lookahead window that does
+// not have one yet.
+func (s *Scheduler) runOnce(ctx context.Context) {
+	started := s.now()
+	s.mu.Lock()
+	s.status.LastRunStarted = &started
+	s.mu.Unlock()
+
+	missing, err := s.missingDates(ctx, started)
+	var generated []string
+	var failures []types.SchedulerFailure
+	if err != nil {
+		failures = append(failures, types.SchedulerFailure{Attempts: 1, Error: err.Error()})
+	}
+
+	for _, date := range missing {
+		attempts, err := s.generate(ctx, date)
+		if err != nil {
+			failures = append(failures, types.SchedulerFailure{Date: date.Format("2006-01-02"), Attempts: attempts, Error: err.Error()})
+			continue
+		}
+		generated = append(generated, date.Format("2006-01-02"))
+	}
+
+	finished := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status.LastRunFinished = &finished
+	s.status.MissingDates = formatDates(missing)
+	s.status.GeneratedDates = generated
+	s.status.Failures = failures
+}
+
+func (s *Scheduler) missingDates(ctx context.Context, now time.Time) ([]time.Time, error) {
+	year, month, day := now.Date()
+	from := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, s.config.LookaheadDays)
+
+	filter := types.GetTriviaFilter{
+		From:  from.Format("2006-01-02"),
+		To:    to.Format("2006-01-02"),
+		Limit: s.config.LookaheadDays + 1,
+	}
+
+	trivia, err := s.service.store.GetAllTrivia(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool)
+	for _, val := range trivia {
+		existing[val.Date.Format("2006-01-02")] = true
+	}
+
+	var missing []time.Time
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		if !existing[date.Format("2006-01-02")] {
+			missing = append(missing, date)
+		}
+	}
+	return missing, nil
+}
+
+// generate creates the trivia for the date, retrying with exponential backoff,
+// and returns the number of attempts made.
+func (s *Scheduler) generate(ctx context.Context, date time.Time) (int, error) {
+	backoff := s.config.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := s.service.createTriviaIfMissing(ctx, date, s.config.Recipe)
+		if err == nil || attempt > s.config.MaxRetries {
+			return attempt, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = backoff * 2
+	}
+}
+
+// createTriviaIfMissing generates the trivia for the date unless it already
+// exists.
+func (s *Service) createTriviaIfMissing(ctx context.Context, date time.Time, recipeName string) error {
+	recipe, err := s.getRecipe(recipeName)
+	if err != nil {
+		return err
+	}
+
+	err = s.withDateLock(ctx, date, func(ctx context.Context, store storage.IStore) error {
+		return s.createTriviaForDate(ctx, store, date, recipe)
+	})
+
+	if errors.Is(err, ErrTriviaExists) {
+		return nil
+	}
+	return err
+}
+
+func formatDates(dates []time.Time) []string {
+	result := []string{}
+	for _, date := range dates {
+		result = append(result, date.Format("2006-01-02"))
+	}
+	return result
+}
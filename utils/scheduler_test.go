@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
+)
+
+func TestSchedulerRunOnce(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name       string
+		failures   int
+		maxRetries int
+		existing   []string
+		generated  []string
+		failed     []types.SchedulerFailure
+	}{
+		{
+			name:       "generates missing dates",
+			failures:   0,
+			maxRetries: 0,
+			existing:   []string{"2022-01-02"},
+			generated:  []string{"2022-01-01", "2022-01-03"},
+			failed:     nil,
+		},
+		{
+			name:       "retries failed dates",
+			failures:   2,
+			maxRetries: 2,
+			existing:   []string{"2022-01-01", "2022-01-02"},
+			generated:  []string{"2022-01-03"},
+			failed:     nil,
+		},
+		{
+			name:       "records failures once retries run out",
+			failures:   3,
+			maxRetries: 2,
+			existing:   []string{"2022-01-01", "2022-01-02"},
+			generated:  nil,
+			failed:     []types.SchedulerFailure{{Date: "2022-01-03", Attempts: 3, Error: "test"}},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := storage.NewSeededMemoryStore()
+			service := NewService(store)
+
+			failures := tc.failures
+			service.Generators().Register("flaky", QuestionGeneratorFunc(func(g *Generation) (int, error) {
+				if failures > 0 {
+					failures--
+					return 0, errors.New("test")
+				}
+				return 0, nil
+			}))
+			service.AddRecipe(types.Recipe{Name: "flaky", QuestionCount: 1, Slots: []types.RecipeSlot{{Generator: "flaky"}, {Generator: "what-flag"}}})
+
+			for _, date := range tc.existing {
+				parsed, _ := time.Parse("2006-01-02", date)
+				if _, err := store.CreateTrivia(ctx, "existing", parsed); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			scheduler := newScheduler(service, SchedulerConfig{LookaheadDays: 2, MaxRetries: tc.maxRetries, RetryBackoff: time.Millisecond, Recipe: "flaky"})
+			scheduler.now = func() time.Time { return now }
+			scheduler.runOnce(ctx)
+
+			status := scheduler.Status()
+			if len(status.GeneratedDates) != len(tc.generated) {
+				t.Fatalf("expected generated dates %v; got %v", tc.generated, status.GeneratedDates)
+			}
+
+			for i, date := range tc.generated {
+				if status.GeneratedDates[i] != date {
+					t.Errorf("expected generated dates %v; got %v", tc.generated, status.GeneratedDates)
+				}
+
+				if _, err := store.GetTrivia(ctx, date); err != nil {
+					t.Errorf("expected trivia for %s; got %v", date, err)
+				}
+			}
+
+			if len(status.Failures) != len(tc.failed) {
+				t.Fatalf("expected failures %+v; got %+v", tc.failed, status.Failures)
+			}
+
+			for i, failure := range tc.failed {
+				if status.Failures[i] != failure {
+					t.Errorf("expected failures %+v; got %+v", tc.failed, status.Failures)
+				}
+			}
+		})
+	}
+}
+
+func TestSchedulerInterval(t *testing.T) {
+	tt := []struct {
+		name     string
+		interval time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "configured",
+			interval: time.Minute,
+			expected: time.Minute,
+		},
+		{
+			name:     "zero",
+			interval: 0,
+			expected: defaultSchedulerInterval,
+		},
+		{
+			name:     "negative",
+			interval: -time.Second,
+			expected: defaultSchedulerInterval,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewService(storage.NewMemoryStore())
+			scheduler := newScheduler(service, SchedulerConfig{LookaheadDays: 1, Interval: tc.interval})
+			if scheduler.config.Interval != tc.expected {
+				t.Errorf("expected interval %v; got %v", tc.expected, scheduler.config.Interval)
+			}
+		})
+	}
+}
+
+func TestGetSchedulerStatusDisabled(t *testing.T) {
+	service := NewService(storage.NewMemoryStore())
+
+	_, err := service.GetSchedulerStatus(context.Background())
+	if !errors.Is(err, ErrSchedulerDisabled) {
+		t.Errorf("expected error %v; got %v", ErrSchedulerDisabled, err)
+	}
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"math/rand"
@@ -11,415 +12,511 @@ import (
 )
 
 type IService interface {
-	CreateTrivia() error
-	RegenerateTrivia(dateString string) error
+	GetTrivia(ctx context.Context, dateString string) (*types.TriviaDto, error)
+	GetAllTrivia(ctx context.Context, filter types.GetTriviaFilter) (*types.TriviaPageDto, error)
+	CreateTrivia(ctx context.Context, recipe string) error
+	RegenerateTrivia(ctx context.Context, dateString, recipe string, pinnedQuestionIDs []int) error
+	PreviewTrivia(ctx context.Context, dateString, recipe string) (*types.TriviaDto, error)
+	RegenerateTriviaQuestion(ctx context.Context, dateString string, questionID int) error
+	GenerateTriviaRange(ctx context.Context, from, to, recipe string, force bool) ([]types.TriviaRangeResult, error)
+	GetCacheStats(ctx context.Context) (map[string]storage.CacheStats, error)
+	InvalidateCache(ctx context.Context, kind string) error
+	GetSchedulerStatus(ctx context.Context) (*types.SchedulerStatus, error)
+	GetAdjacency(ctx context.Context, className string) (*types.AdjacencyDto, error)
 }
 
+const (
+	defaultTriviaPageLimit = 10
+	maxTriviaPageLimit     = 100
+)
+
 type Service struct {
-	store storage.IStore
+	store             storage.IStore
+	generators        *GeneratorRegistry
+	recipes           map[string]types.Recipe
+	generationTimeout time.Duration
+	clock             Clock
+	scheduler         *Scheduler
+	geometry          *geometryCache
+	distractors       map[string]DistractorStrategy
 }
 
 func NewService(store storage.IStore) *Service {
+	geometries := newGeometryCache()
+	generators := NewGeneratorRegistry()
+	if err := registerDefaultGenerators(generators, geometries); err != nil {
+		panic(err)
+	}
+
 	return &Service{
 		store,
+		generators,
+		map[string]types.Recipe{
+			DefaultRecipe.Name: DefaultRecipe,
+		},
+		0,
+		NewClock(time.Local),
+		nil,
+		geometries,
+		map[string]DistractorStrategy{
+			"pick-flag": similarFlagDistractors{},
+		},
 	}
 }
 
-func (s *Service) CreateTrivia() error {
-	date := time.Now().AddDate(0, 0, 1)
-	return s.createTriviaForDate(date)
+// SetGenerationTimeout limits how long creating or regenerating a single trivia
+// may take. A zero timeout leaves generation bound only by the caller's context.
+func (s *Service) SetGenerationTimeout(timeout time.Duration) {
+	s.generationTimeout = timeout
+}
+
+// SetClock replaces the clock used to work out quiz dates, which otherwise reads
+// the server's local time.
+func (s *Service) SetClock(clock Clock) {
+	s.clock = clock
 }
 
-func (s *Service) RegenerateTrivia(dateString string) error {
-	_, err := time.Parse("2006-02-01", dateString)
+// Generators returns the registry used to build the auto-generated questions so
+// that additional question kinds can be registered.
+func (s *Service) Generators() *GeneratorRegistry {
+	return s.generators
+}
+
+func (s *Service) GetTrivia(ctx context.Context, dateString string) (*types.TriviaDto, error) {
+	date, err := s.parseDate(dateString)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	trivia, err := s.store.GetTrivia(dateString)
-	if err != nil && err != sql.ErrNoRows {
-		return err
+	dateString = date.Format("2006-01-02")
+	trivia, err := s.store.GetTrivia(ctx, dateString)
+	if err == sql.ErrNoRows {
+		return nil, triviaNotFoundError(dateString)
 	}
 
-	if err != sql.ErrNoRows {
-		if err = s.store.DeleteTrivia(trivia); err != nil {
-			return err
-		}
+	if err != nil {
+		return nil, err
 	}
-
-	date, err := time.Parse("2006-01-02", dateString)
-	return s.createTriviaForDate(date)
+	return trivia, resolveMaps(ctx, s.store, trivia)
 }
 
-func (s *Service) createTriviaForDate(date time.Time) error {
-	doesNotExist, err := s.store.TriviaDoesNotExistForDate(date)
-	if !doesNotExist {
-		return fmt.Errorf("trivia for date %s already exists", date)
-	}
+// resolveMaps fills in the map of every question that shows one. Maps are read
+// through the store, so a cached store only loads each map once.
+func resolveMaps(ctx context.Context, store storage.IStore, trivia *types.TriviaDto) error {
+	maps := make(map[string]types.MapDto)
+	for i, question := range trivia.Questions {
+		if question.MapName == "" {
+			continue
+		}
 
-	_, month, day := date.Date()
-	weekday := date.Weekday().String()
-	name := fmt.Sprintf("%s, %s %d", weekday, month, day)
-	id, err := s.store.CreateTrivia(name, date)
-	if err != nil {
-		return err
+		svgMap, ok := maps[question.MapName]
+		if !ok {
+			var err error
+			svgMap, err = store.GetMap(ctx, question.MapName)
+			if err != nil {
+				return err
+			}
+			maps[question.MapName] = svgMap
+		}
+		trivia.Questions[i].Map = svgMap
 	}
+	return nil
+}
 
-	count, err := s.generateQuestions(id, 10)
+func (s *Service) GetAllTrivia(ctx context.Context, filter types.GetTriviaFilter) (*types.TriviaPageDto, error) {
+	from, to, err := s.parseDateRange(filter.From, filter.To)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return s.store.SetTriviaMaxScore(id, count)
-}
+	if filter.From != "" {
+		filter.From = from.Format("2006-01-02")
+	}
 
-func (s *Service) generateQuestions(triviaId, max int) (int, error) {
-	countries, err := s.store.GetMappingEntries("world-countries")
-	if err != nil {
-		return 0, err
+	if filter.To != "" {
+		filter.To = to.Format("2006-01-02")
 	}
 
-	capitals, err := s.store.GetMappingEntries("world-capitals")
-	if err != nil {
-		return 0, err
+	if filter.Page < 0 {
+		return nil, validationError(map[string]interface{}{"page": filter.Page}, "page cannot be negative")
 	}
 
-	states, err := s.store.GetMappingEntries("us-states")
-	if err != nil {
-		return 0, err
+	if filter.Limit == 0 {
+		filter.Limit = defaultTriviaPageLimit
 	}
 
-	count := 0
-	err = s.whatCountry(triviaId, countries)
-	if err != nil {
-		return count, err
+	if filter.Limit < 0 || filter.Limit > maxTriviaPageLimit {
+		return nil, validationError(map[string]interface{}{"limit": filter.Limit}, "limit must be between 1 and %d", maxTriviaPageLimit)
 	}
 
-	err = s.whatCapital(triviaId, countries, capitals)
+	trivia, err := s.store.GetAllTrivia(ctx, filter)
 	if err != nil {
-		return count, err
+		return nil, err
 	}
 
-	err = s.whatUSState(triviaId, states)
-	if err != nil {
-		return count, err
+	hasMore := len(trivia) > filter.Limit
+	if hasMore {
+		trivia = trivia[:filter.Limit]
 	}
 
-	err = s.whatFlag(triviaId, countries)
+	return &types.TriviaPageDto{
+		Trivia:  trivia,
+		HasMore: hasMore,
+	}, nil
+}
+
+func (s *Service) CreateTrivia(ctx context.Context, recipeName string) error {
+	recipe, err := s.getRecipe(recipeName)
 	if err != nil {
-		return count, err
+		return err
 	}
-	count = count + 4
 
-	questions, err := s.store.GetTodaysManualTriviaQuestions()
-	if err != nil && err != sql.ErrNoRows {
-		return count, err
+	date := s.tomorrow()
+	return s.withDateLock(ctx, date, func(ctx context.Context, store storage.IStore) error {
+		return s.createTriviaForDate(ctx, store, date, recipe)
+	})
+}
+
+// RegenerateTrivia rebuilds the trivia for a date. Questions listed in
+// pinnedQuestionIDs are kept and only the remaining slots are generated again.
+func (s *Service) RegenerateTrivia(ctx context.Context, dateString, recipeName string, pinnedQuestionIDs []int) error {
+	date, err := s.parseDate(dateString)
+	if err != nil {
+		return err
 	}
 
-	todaysQuestionCount, err := s.createQuestionsAndAnswers(questions, triviaId, max-count)
+	recipe, err := s.getRecipe(recipeName)
 	if err != nil {
-		return count, err
+		return err
 	}
 
-	count = count + todaysQuestionCount
-	if count < max {
-		categories, err := s.store.GetTriviaQuestionCategories(true)
-		if err != nil {
-			return count, err
+	dateString = date.Format("2006-01-02")
+	return s.withDateLock(ctx, date, func(ctx context.Context, store storage.IStore) error {
+		if len(pinnedQuestionIDs) > 0 {
+			return s.regenerateUnpinnedQuestions(ctx, store, dateString, recipe, pinnedQuestionIDs)
 		}
 
-		remainder := max - count
-		if len(categories) < remainder {
-			return count, fmt.Errorf("need to generate %d more questions but only %d available categories", remainder, len(categories))
+		if err := deleteTriviaForDate(ctx, store, dateString); err != nil {
+			return err
 		}
+		return s.createTriviaForDate(ctx, store, date, recipe)
+	})
+}
 
-		var allowedCategories []types.TriviaQuestionCategory
-		for i := 0; i < remainder; i++ {
-			index := rand.Intn(len(categories))
-			allowedCategories = append(allowedCategories, categories[index])
-			categories = append(categories[:index], categories[index+1:]...)
+func (s *Service) regenerateUnpinnedQuestions(ctx context.Context, store storage.IStore, dateString string, recipe types.Recipe, pinnedQuestionIDs []int) error {
+	trivia, err := store.GetTrivia(ctx, dateString)
+	if err == sql.ErrNoRows {
+		return triviaNotFoundError(dateString)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	pinned := make(map[int]bool)
+	for _, id := range pinnedQuestionIDs {
+		if !containsQuestion(trivia.Questions, id) {
+			return questionNotFoundError(dateString, id)
 		}
+		pinned[id] = true
+	}
 
-		maxTextCount := remainder / 2
-		var textCategories []int
-		var imageCategories []int
-		for i := 0; i < len(allowedCategories); i++ {
-			curr := allowedCategories[i]
-			if !curr.ImageOnly && len(textCategories) < maxTextCount {
-				textCategories = append(textCategories, curr.ID)
-			} else {
-				imageCategories = append(imageCategories, curr.ID)
+	if err := store.ClearTriviaPlayTriviaId(ctx, trivia.ID); err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	generation := s.newGeneration(ctx, store, trivia.ID, trivia.Date)
+	for _, question := range trivia.Questions {
+		if pinned[question.ID] {
+			if err := s.pinQuestion(generation, question.ID); err != nil {
+				return err
 			}
+			continue
 		}
 
-		textQuestionCount, err := s.setRandomManualTriviaQuestions(triviaId, types.QUESTION_TYPE_TEXT, maxTextCount, textCategories)
-		if err != nil && err != sql.ErrNoRows {
-			return count, err
+		if err := store.DeleteTriviaAnswers(ctx, question.ID); err != nil && err != sql.ErrNoRows {
+			return err
 		}
-		count = count + textQuestionCount
 
-		imageQuestionCount, err := s.setRandomManualTriviaQuestions(triviaId, types.QUESTION_TYPE_IMAGE, max-count, imageCategories)
-		if err != nil && err != sql.ErrNoRows {
-			return count, err
+		if err := store.DeleteTriviaQuestion(ctx, question.ID); err != nil {
+			return err
 		}
-		count = count + imageQuestionCount
 	}
 
-	return count, nil
-}
-
-func getCountry(countries []types.MappingEntryDto, country string) (int, error) {
-	for i, val := range countries {
-		if val.SVGName == country {
-			return i, nil
-		}
+	count, err := s.generateQuestions(generation, recipe)
+	if err != nil {
+		return err
 	}
-	return 0, fmt.Errorf("unable to find country %s in country mappings", country)
-}
 
-func (s *Service) whatCountry(triviaId int, countries []types.MappingEntryDto) error {
-	max := len(types.TopLandmass)
-	index := rand.Intn(max)
-	country := types.TopLandmass[index]
-
-	question := types.TriviaQuestion{
-		TriviaId:    triviaId,
-		TypeID:      types.QUESTION_TYPE_MAP,
-		Question:    "Which country is highlighted above?",
-		Map:         "WorldCountries",
-		Highlighted: country,
-	}
+	return store.SetTriviaMaxScore(ctx, trivia.ID, count)
+}
 
-	questionId, err := s.store.CreateTriviaQuestion(question)
+// pinQuestion records a kept question on the generation so that recipe slots it
+// already satisfies are skipped and its manual category is not used twice.
+func (s *Service) pinQuestion(g *Generation, questionID int) error {
+	question, err := g.store.GetTriviaQuestion(g.ctx, questionID)
 	if err != nil {
 		return err
 	}
 
-	answer := types.TriviaAnswer{
-		TriviaQuestionID: questionId,
-		Text:             country,
-		IsCorrect:        true,
+	g.pinned = append(g.pinned, question)
+	if question.ManualQuestionID == 0 {
+		return nil
 	}
 
-	err = s.store.CreateTriviaAnswer(answer)
+	manualQuestion, err := g.store.GetManualTriviaQuestion(g.ctx, question.ManualQuestionID)
 	if err != nil {
 		return err
 	}
 
-	index, err = getCountry(countries, country)
+	g.usedCategories[manualQuestion.CategoryID] = true
+	return nil
+}
+
+// PreviewTrivia runs the full generation for a date, defaulting to tomorrow, and
+// returns the result without keeping any of it. Any existing trivia for the date is
+// replaced in the preview exactly as it would be by RegenerateTrivia.
+func (s *Service) PreviewTrivia(ctx context.Context, dateString, recipeName string) (*types.TriviaDto, error) {
+	date := s.tomorrow()
+	if dateString != "" {
+		var err error
+		if date, err = s.parseDate(dateString); err != nil {
+			return nil, err
+		}
+	}
+	dateString = date.Format("2006-01-02")
+
+	recipe, err := s.getRecipe(recipeName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	countries = append(countries[:index], countries[index+1:]...)
-	max = len(countries)
+	var trivia *types.TriviaDto
+	err = s.withDateLock(ctx, date, func(ctx context.Context, store storage.IStore) error {
+		if err := deleteTriviaForDate(ctx, store, dateString); err != nil {
+			return err
+		}
 
-	for i := 0; i < 3; i++ {
-		index := rand.Intn(max)
-		country = countries[index].SVGName
-		answer := types.TriviaAnswer{
-			TriviaQuestionID: questionId,
-			Text:             country,
-			IsCorrect:        false,
+		if err := s.createTriviaForDate(ctx, store, date, recipe); err != nil {
+			return err
 		}
 
-		err = s.store.CreateTriviaAnswer(answer)
+		preview, err := store.GetTrivia(ctx, dateString)
 		if err != nil {
 			return err
 		}
 
-		countries = append(countries[:index], countries[index+1:]...)
-		max = max - 1
-	}
-
-	return nil
-}
-
-func (s *Service) whatCapital(triviaId int, countries []types.MappingEntryDto, capitals []types.MappingEntryDto) error {
-	max := len(types.TopLandmass)
-	index := rand.Intn(max)
-	country := types.TopLandmass[index]
-	var code string
-	for _, val := range countries {
-		if val.SVGName == country {
-			code = val.Code
-			break
+		if err := resolveMaps(ctx, store, preview); err != nil {
+			return err
 		}
-	}
 
-	var capitalName string
-	for _, value := range capitals {
-		if value.Code == code {
-			capitalName = value.SVGName
-		}
-	}
+		trivia = preview
+		return errPreviewRollback
+	})
 
-	question := types.TriviaQuestion{
-		TriviaId:    triviaId,
-		TypeID:      types.QUESTION_TYPE_MAP,
-		Question:    fmt.Sprintf("What is the capital city of %s?", country),
-		Map:         "WorldCapitals",
-		Highlighted: capitalName,
+	if err != errPreviewRollback {
+		return nil, err
 	}
+	return trivia, nil
+}
 
-	questionId, err := s.store.CreateTriviaQuestion(question)
+// RegenerateTriviaQuestion replaces a single question in an existing trivia with a
+// new question from the same generator or manual question category.
+func (s *Service) RegenerateTriviaQuestion(ctx context.Context, dateString string, questionID int) error {
+	date, err := s.parseDate(dateString)
 	if err != nil {
 		return err
 	}
 
-	answer := types.TriviaAnswer{
-		TriviaQuestionID: questionId,
-		Text:             capitalName,
-		IsCorrect:        true,
-	}
-	err = s.store.CreateTriviaAnswer(answer)
-	if err != nil {
-		return err
-	}
+	dateString = date.Format("2006-01-02")
+	return s.withDateLock(ctx, date, func(ctx context.Context, store storage.IStore) error {
+		trivia, err := store.GetTrivia(ctx, dateString)
+		if err == sql.ErrNoRows {
+			return triviaNotFoundError(dateString)
+		}
 
-	for i, val := range capitals {
-		if val.SVGName == capitalName {
-			index = i
-			break
+		if err != nil {
+			return err
 		}
-	}
 
-	capitals = append(capitals[:index], capitals[index+1:]...)
-	max = len(capitals)
-	for i := 0; i < 3; i++ {
-		index := rand.Intn(max)
-		capital := capitals[index]
-		answer := types.TriviaAnswer{
-			TriviaQuestionID: questionId,
-			Text:             capital.SVGName,
-			IsCorrect:        false,
+		if !containsQuestion(trivia.Questions, questionID) {
+			return questionNotFoundError(dateString, questionID)
 		}
 
-		err = s.store.CreateTriviaAnswer(answer)
+		question, err := store.GetTriviaQuestion(ctx, questionID)
 		if err != nil {
 			return err
 		}
 
-		capitals = append(capitals[:index], capitals[index+1:]...)
-		max = max - 1
-	}
+		if err := store.DeleteTriviaAnswers(ctx, questionID); err != nil && err != sql.ErrNoRows {
+			return err
+		}
 
-	return nil
+		if err := store.DeleteTriviaQuestion(ctx, questionID); err != nil {
+			return err
+		}
+
+		generated, err := s.replaceQuestion(s.newGeneration(ctx, store, trivia.ID, trivia.Date), question)
+		if err != nil {
+			return err
+		}
+
+		if generated == 0 {
+			return NewServiceError(ERROR_CODE_CONFLICT, ErrNoReplacement, map[string]interface{}{"questionId": questionID}, "no replacement available for question %d", questionID)
+		}
+
+		return store.SetTriviaMaxScore(ctx, trivia.ID, len(trivia.Questions)-1+generated)
+	})
 }
 
-func (s *Service) whatUSState(triviaId int, states []types.MappingEntryDto) error {
-	max := len(states)
-	index := rand.Intn(max)
-	state := states[index]
-	states = append(states[:index], states[index+1:]...)
-	max = max - 1
+func (s *Service) replaceQuestion(g *Generation, question types.TriviaQuestion) (int, error) {
+	if question.Generator != "" {
+		generator, err := s.generators.Get(question.Generator)
+		if err != nil {
+			return 0, err
+		}
+		return g.runGenerator(question.Generator, generator)
+	}
 
-	question := types.TriviaQuestion{
-		TriviaId:    triviaId,
-		TypeID:      types.QUESTION_TYPE_MAP,
-		Question:    "Which US state is highlighted above?",
-		Map:         "UsStates",
-		Highlighted: state.SVGName,
+	if question.ManualQuestionID == 0 {
+		return 0, validationError(map[string]interface{}{"questionId": question.ID}, "question %d has no recorded generator or manual question", question.ID)
 	}
 
-	questionId, err := s.store.CreateTriviaQuestion(question)
+	manualQuestion, err := g.store.GetManualTriviaQuestion(g.ctx, question.ManualQuestionID)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	lastUsedMax := g.Date.AddDate(0, 0, -7)
+	candidates, err := g.store.GetManualTriviaQuestions(g.ctx, manualQuestion.TypeID, lastUsedMax.Format("2006-01-02"), []int{manualQuestion.CategoryID})
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
 	}
 
-	answer := types.TriviaAnswer{
-		TriviaQuestionID: questionId,
-		Text:             state.SVGName,
-		IsCorrect:        true,
+	var questions []types.ManualTriviaQuestion
+	for _, candidate := range candidates {
+		if candidate.ID != manualQuestion.ID {
+			questions = append(questions, candidate)
+		}
+	}
+
+	return s.createQuestionsAndAnswers(g, questions, 1)
+}
+
+func containsQuestion(questions []types.QuestionDto, questionID int) bool {
+	for _, question := range questions {
+		if question.ID == questionID {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteTriviaForDate(ctx context.Context, store storage.IStore, dateString string) error {
+	trivia, err := store.GetTrivia(ctx, dateString)
+	if err == sql.ErrNoRows {
+		return nil
 	}
 
-	err = s.store.CreateTriviaAnswer(answer)
 	if err != nil {
 		return err
 	}
+	return store.DeleteTrivia(ctx, trivia)
+}
 
-	for i := 0; i < 3; i++ {
-		index := rand.Intn(max)
-		state = states[index]
-		answer := types.TriviaAnswer{
-			TriviaQuestionID: questionId,
-			Text:             state.SVGName,
-			IsCorrect:        false,
-		}
+// withDateLock runs fn in a transaction that holds the generation lock for the
+// date, so overlapping requests for the same date cannot both build a trivia. The
+// transaction is rolled back if ctx is cancelled or the generation timeout passes.
+func (s *Service) withDateLock(ctx context.Context, date time.Time, fn func(ctx context.Context, store storage.IStore) error) error {
+	if s.generationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.generationTimeout)
+		defer cancel()
+	}
 
-		err = s.store.CreateTriviaAnswer(answer)
+	return s.store.WithTransaction(ctx, func(store storage.IStore) error {
+		locked, err := store.LockTriviaDate(ctx, date)
 		if err != nil {
 			return err
 		}
 
-		states = append(states[:index], states[index+1:]...)
-		max = max - 1
-	}
+		if !locked {
+			return NewServiceError(ERROR_CODE_CONFLICT, ErrGenerationInProgress, map[string]interface{}{"date": date.Format("2006-01-02")}, "trivia generation for date %s is already in progress", date.Format("2006-01-02"))
+		}
 
-	return nil
+		return fn(ctx, store)
+	})
 }
 
-func (s *Service) whatFlag(triviaId int, countries []types.MappingEntryDto) error {
-	max := len(countries)
-	index := rand.Intn(max)
-	country := countries[index]
-	countries = append(countries[:index], countries[index+1:]...)
-	max = max - 1
+// createTriviaForDate builds a complete trivia using the given store. Callers are
+// expected to pass a transaction scoped store so that a failure part way through
+// generation does not leave a partial trivia behind.
+func (s *Service) createTriviaForDate(ctx context.Context, store storage.IStore, date time.Time, recipe types.Recipe) error {
+	doesNotExist, err := store.TriviaDoesNotExistForDate(ctx, date)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
 
-	question := types.TriviaQuestion{
-		TriviaId: triviaId,
-		TypeID:   types.QUESTION_TYPE_FLAG,
-		Question: "Which country has this flag?",
-		FlagCode: country.Code,
+	if !doesNotExist {
+		return NewServiceError(ERROR_CODE_CONFLICT, ErrTriviaExists, map[string]interface{}{"date": date.Format("2006-01-02")}, "trivia for date %s already exists", date.Format("2006-01-02"))
 	}
 
-	questionId, err := s.store.CreateTriviaQuestion(question)
+	_, month, day := date.Date()
+	weekday := date.Weekday().String()
+	name := fmt.Sprintf("%s, %s %d", weekday, month, day)
+	id, err := store.CreateTrivia(ctx, name, date)
 	if err != nil {
 		return err
 	}
 
-	answer := types.TriviaAnswer{
-		TriviaQuestionID: questionId,
-		Text:             country.SVGName,
-		IsCorrect:        true,
-	}
-	err = s.store.CreateTriviaAnswer(answer)
+	count, err := s.generateQuestions(s.newGeneration(ctx, store, id, date), recipe)
 	if err != nil {
 		return err
 	}
 
-	for i := 0; i < 3; i++ {
-		index := rand.Intn(max)
-		country = countries[index]
-		answer := types.TriviaAnswer{
-			TriviaQuestionID: questionId,
-			Text:             country.SVGName,
-			IsCorrect:        false,
+	return store.SetTriviaMaxScore(ctx, id, count)
+}
+
+// generateQuestions fills the recipe slots in order and returns the number of
+// questions in the trivia, including any questions pinned on the generation.
+func (s *Service) generateQuestions(generation *Generation, recipe types.Recipe) (int, error) {
+	count := len(generation.pinned)
+	for _, slot := range recipe.Slots {
+		remaining := recipe.QuestionCount - count
+		if remaining <= 0 {
+			break
 		}
 
-		err = s.store.CreateTriviaAnswer(answer)
-		if err != nil {
-			return err
+		var generated int
+		var err error
+		if slot.Generator != "" {
+			generated, err = s.fillGeneratorSlot(generation, slot, remaining)
+		} else {
+			generated, err = s.fillManualSlot(generation, slot, remaining)
 		}
 
-		countries = append(countries[:index], countries[index+1:]...)
-		max = max - 1
+		count = count + generated
+		if err != nil {
+			return count, err
+		}
 	}
 
-	return nil
+	return count, nil
 }
 
-func (s *Service) setRandomManualTriviaQuestions(triviaID, typeID, quantity int, allowedCategories []int) (int, error) {
-	lastUsedMax := time.Now().AddDate(0, 0, -7)
-	questions, err := s.store.GetManualTriviaQuestions(typeID, lastUsedMax.Format("2006-01-02"), allowedCategories)
+func (s *Service) setRandomManualTriviaQuestions(g *Generation, typeID, quantity int, allowedCategories []int) (int, error) {
+	lastUsedMax := g.Date.AddDate(0, 0, -7)
+	questions, err := g.store.GetManualTriviaQuestions(g.ctx, typeID, lastUsedMax.Format("2006-01-02"), allowedCategories)
 	if err != nil {
 		return 0, err
 	}
 
-	return s.createQuestionsAndAnswers(questions, triviaID, quantity)
+	return s.createQuestionsAndAnswers(g, questions, quantity)
 }
 
-func (s *Service) createQuestionsAndAnswers(questions []types.ManualTriviaQuestion, triviaID, quantity int) (int, error) {
+func (s *Service) createQuestionsAndAnswers(g *Generation, questions []types.ManualTriviaQuestion, quantity int) (int, error) {
+	questions = g.withoutPinnedManualQuestions(questions)
+
 	count := 0
 	for i := 0; i < quantity; i++ {
 		if len(questions) == 0 {
@@ -431,7 +528,7 @@ func (s *Service) createQuestionsAndAnswers(questions []types.ManualTriviaQuesti
 		manualQuestion := questions[index]
 
 		question := types.TriviaQuestion{
-			TriviaId:           triviaID,
+			ManualQuestionID:   manualQuestion.ID,
 			TypeID:             manualQuestion.TypeID,
 			Question:           manualQuestion.Question,
 			Explainer:          manualQuestion.Explainer,
@@ -446,12 +543,12 @@ func (s *Service) createQuestionsAndAnswers(questions []types.ManualTriviaQuesti
 			ImageAlt:           manualQuestion.ImageAlt,
 		}
 
-		questionID, err := s.store.CreateTriviaQuestion(question)
+		questionID, err := g.CreateQuestion(question)
 		if err != nil {
 			return count, err
 		}
 
-		answers, err := s.store.GetManualTriviaAnswers(manualQuestion.ID)
+		answers, err := g.store.GetManualTriviaAnswers(g.ctx, manualQuestion.ID)
 		if err != nil {
 			return count, err
 		}
@@ -464,15 +561,16 @@ func (s *Service) createQuestionsAndAnswers(questions []types.ManualTriviaQuesti
 				FlagCode:         answer.FlagCode,
 			}
 
-			if err := s.store.CreateTriviaAnswer(newAnswer); err != nil {
+			if err := g.CreateAnswer(newAnswer); err != nil {
 				return count, err
 			}
 		}
 
 		questions = append(questions[:index], questions[index+1:]...)
-		if err := s.store.UpdateManualTriviaQuestionLastUsed(manualQuestion.ID); err != nil {
+		if err := g.store.UpdateManualTriviaQuestionLastUsed(g.ctx, manualQuestion.ID, g.Date.Format("2006-01-02")); err != nil {
 			return count, err
 		}
+		g.usedCategories[manualQuestion.CategoryID] = true
 		count = count + 1
 	}
 
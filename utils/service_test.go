@@ -1,9 +1,14 @@
 package utils
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/geobuff/generate/storage"
+	"github.com/geobuff/generate/types"
 )
 
 func TestCreateTrivia(t *testing.T) {
@@ -19,24 +24,119 @@ func TestCreateTrivia(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			store := storage.NewMockStore()
+			store := storage.NewSeededMemoryStore()
 			service := NewService(store)
 
-			err := service.CreateTrivia()
+			err := service.CreateTrivia(context.Background(), "")
 
 			if tc.expected != "" && err != nil && err.Error() != tc.expected {
 				t.Error(err)
 			}
+
+			if tc.expected != "" {
+				return
+			}
+
+			trivia, err := store.GetTrivia(context.Background(), time.Now().AddDate(0, 0, 1).Format("2006-01-02"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(trivia.Questions) != DefaultRecipe.QuestionCount || trivia.MaxScore != DefaultRecipe.QuestionCount {
+				t.Errorf("expected %d questions and max score; got %d questions and max score %d", DefaultRecipe.QuestionCount, len(trivia.Questions), trivia.MaxScore)
+			}
+
+			for _, question := range trivia.Questions {
+				if len(question.Answers) < 2 {
+					t.Errorf("expected question %d to have answers; got %d", question.ID, len(question.Answers))
+				}
+			}
 		})
 	}
 }
 
-func BenchmarkCreateTrivia(b *testing.B) {
-	store := storage.NewMockStore()
-	service := NewService(store)
+// fixedClock always returns the same time.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
 
+func TestCreateTriviaInQuizTimezone(t *testing.T) {
+	auckland := time.FixedZone("NZDT", 13*60*60)
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name     string
+		clock    Clock
+		expected string
+	}{
+		{
+			name:     "utc",
+			clock:    fixedClock(now),
+			expected: "2022-01-02",
+		},
+		{
+			name:     "ahead of utc",
+			clock:    fixedClock(now.In(auckland)),
+			expected: "2022-01-03",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := storage.NewSeededMemoryStore()
+			quizDate, _ := time.Parse("2006-01-02", tc.expected)
+			scheduled := store.AddManualTriviaQuestion(types.ManualTriviaQuestion{
+				TypeID:     types.QUESTION_TYPE_TEXT,
+				CategoryID: 1,
+				Question:   "Scheduled question",
+				QuizDate:   sql.NullTime{Time: quizDate, Valid: true},
+			}, []types.ManualTriviaAnswer{{Text: "Yes", IsCorrect: true}, {Text: "No"}})
+
+			service := NewService(store)
+			service.SetClock(tc.clock)
+
+			if err := service.CreateTrivia(ctx, ""); err != nil {
+				t.Fatal(err)
+			}
+
+			trivia, err := store.GetTrivia(ctx, tc.expected)
+			if err != nil {
+				t.Fatalf("expected trivia for %s; got %v", tc.expected, err)
+			}
+
+			var found bool
+			for _, question := range trivia.Questions {
+				if question.Question == "Scheduled question" {
+					found = true
+				}
+			}
+
+			if !found {
+				t.Errorf("expected the question scheduled for %s to be used", tc.expected)
+			}
+
+			question, err := store.GetManualTriviaQuestion(ctx, scheduled)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if question.LastUsed.Time.Format("2006-01-02") != tc.expected {
+				t.Errorf("expected question to be last used on %s; got %v", tc.expected, question.LastUsed.Time)
+			}
+		})
+	}
+}
+
+func BenchmarkCreateTrivia(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		service.CreateTrivia()
+		b.StopTimer()
+		service := NewService(storage.NewSeededMemoryStore())
+		b.StartTimer()
+
+		service.CreateTrivia(context.Background(), "")
 	}
 }
 
@@ -49,34 +149,449 @@ func TestRegenerateTrivia(t *testing.T) {
 		{
 			name:     "invalid date",
 			date:     "",
-			expected: "parsing time \"\" as \"2006-02-01\": cannot parse \"\" as \"2006\"",
+			expected: "date  must be in the format YYYY-MM-DD or one of today, tomorrow, yesterday or +Nd",
+		},
+		{
+			name:     "day and month swapped",
+			date:     "2022-13-01",
+			expected: "date 2022-13-01 must be in the format YYYY-MM-DD or one of today, tomorrow, yesterday or +Nd",
 		},
 		{
 			name:     "happy path",
 			date:     "2022-01-01",
 			expected: "",
 		},
+		{
+			name:     "day after the twelfth",
+			date:     "2022-01-13",
+			expected: "",
+		},
+		{
+			name:     "relative date",
+			date:     "+3d",
+			expected: "",
+		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			store := storage.NewMockStore()
+			store := storage.NewSeededMemoryStore()
 			service := NewService(store)
 
-			err := service.RegenerateTrivia(tc.date)
+			err := service.RegenerateTrivia(context.Background(), tc.date, "", nil)
 
-			if tc.expected != "" && err != nil && err.Error() != tc.expected {
-				t.Error(err)
+			if tc.expected == "" && err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.expected != "" && (err == nil || err.Error() != tc.expected) {
+				t.Errorf("expected error %s; got %v", tc.expected, err)
 			}
 		})
 	}
 }
 
 func BenchmarkRegenerateTrivia(b *testing.B) {
-	store := storage.NewMockStore()
+	store := storage.NewSeededMemoryStore()
 	service := NewService(store)
 
 	for n := 0; n < b.N; n++ {
-		service.RegenerateTrivia("2022-01-01")
+		service.RegenerateTrivia(context.Background(), "2022-01-01", "", nil)
+	}
+}
+
+type transactionStore struct {
+	*storage.MemoryStore
+	rolledBack bool
+	committed  bool
+}
+
+func (s *transactionStore) WithTransaction(ctx context.Context, fn func(store storage.IStore) error) error {
+	if err := fn(s); err != nil {
+		s.rolledBack = true
+		return err
+	}
+	s.committed = true
+	return nil
+}
+
+func (s *transactionStore) LockTriviaDate(ctx context.Context, date time.Time) (bool, error) {
+	return true, nil
+}
+
+func TestCreateTriviaRollsBackOnError(t *testing.T) {
+	store := storage.NewSeededMemoryStore()
+	service := NewService(store)
+	service.Generators().Register("fail", QuestionGeneratorFunc(func(g *Generation) (int, error) {
+		return 0, errors.New("test")
+	}))
+	service.AddRecipe(types.Recipe{Name: "fail", QuestionCount: 2, Slots: []types.RecipeSlot{{Generator: "what-flag", Count: 1}, {Generator: "fail"}}})
+
+	err := service.CreateTrivia(context.Background(), "fail")
+	if err == nil {
+		t.Fatal("expected error; got nil")
+	}
+
+	_, err = store.GetTrivia(context.Background(), time.Now().AddDate(0, 0, 1).Format("2006-01-02"))
+	if err != sql.ErrNoRows {
+		t.Errorf("expected trivia to be rolled back; got %v", err)
+	}
+}
+
+func TestCreateTriviaCancelled(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tt := []struct {
+		name     string
+		ctx      context.Context
+		timeout  time.Duration
+		expected error
+	}{
+		{
+			name:     "cancelled context",
+			ctx:      cancelled,
+			expected: context.Canceled,
+		},
+		{
+			name:     "generation timeout",
+			ctx:      context.Background(),
+			timeout:  time.Millisecond,
+			expected: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &transactionStore{MemoryStore: storage.NewSeededMemoryStore()}
+			service := NewService(store)
+			service.SetGenerationTimeout(tc.timeout)
+			service.Generators().Register("wait", QuestionGeneratorFunc(func(g *Generation) (int, error) {
+				<-g.Context().Done()
+				return 0, g.Context().Err()
+			}))
+			service.AddRecipe(types.Recipe{Name: "wait", QuestionCount: 1, Slots: []types.RecipeSlot{{Generator: "wait"}}})
+
+			err := service.CreateTrivia(tc.ctx, "wait")
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected %v; got %v", tc.expected, err)
+			}
+
+			if ToServiceError(err).Code != ERROR_CODE_DEPENDENCY {
+				t.Errorf("expected code %s; got %s", ERROR_CODE_DEPENDENCY, ToServiceError(err).Code)
+			}
+
+			if !store.rolledBack || store.committed {
+				t.Errorf("expected transaction to be rolled back")
+			}
+		})
+	}
+}
+
+func TestCreateTriviaWhileDateLocked(t *testing.T) {
+	store := storage.NewSeededMemoryStore()
+	service := NewService(store)
+
+	store.WithTransaction(context.Background(), func(tx storage.IStore) error {
+		locked, err := tx.LockTriviaDate(context.Background(), time.Now().AddDate(0, 0, 1))
+		if err != nil || !locked {
+			t.Fatalf("expected to take date lock; got %v, %v", locked, err)
+		}
+
+		err = service.CreateTrivia(context.Background(), "")
+		if !errors.Is(err, ErrGenerationInProgress) {
+			t.Errorf("expected %v; got %v", ErrGenerationInProgress, err)
+		}
+		return nil
+	})
+
+	if err := service.CreateTrivia(context.Background(), ""); errors.Is(err, ErrGenerationInProgress) {
+		t.Errorf("expected lock to be released; got %v", err)
+	}
+}
+
+func TestGetAllTrivia(t *testing.T) {
+	tt := []struct {
+		name     string
+		filter   types.GetTriviaFilter
+		expected error
+	}{
+		{
+			name:     "invalid from date",
+			filter:   types.GetTriviaFilter{From: "2022-13-01"},
+			expected: ErrInvalidInput,
+		},
+		{
+			name:     "from after to",
+			filter:   types.GetTriviaFilter{From: "2022-02-01", To: "2022-01-01"},
+			expected: ErrInvalidInput,
+		},
+		{
+			name:     "limit too large",
+			filter:   types.GetTriviaFilter{Limit: 1000},
+			expected: ErrInvalidInput,
+		},
+		{
+			name:     "happy path",
+			filter:   types.GetTriviaFilter{From: "2022-01-01", To: "2022-02-01"},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewService(storage.NewSeededMemoryStore())
+
+			_, err := service.GetAllTrivia(context.Background(), tc.filter)
+			if !errors.Is(err, tc.expected) {
+				t.Errorf("expected error %v; got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestPreviewTrivia(t *testing.T) {
+	tt := []struct {
+		name     string
+		date     string
+		expected error
+	}{
+		{
+			name:     "invalid date",
+			date:     "2022-13-01",
+			expected: ErrInvalidInput,
+		},
+		{
+			name:     "defaults to tomorrow",
+			date:     "",
+			expected: nil,
+		},
+		{
+			name:     "happy path",
+			date:     "2022-01-01",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &transactionStore{MemoryStore: storage.NewSeededMemoryStore()}
+			service := NewService(store)
+
+			trivia, err := service.PreviewTrivia(context.Background(), tc.date, "")
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected error %v; got %v", tc.expected, err)
+			}
+
+			if tc.expected != nil {
+				return
+			}
+
+			if trivia == nil {
+				t.Fatal("expected preview trivia; got nil")
+			}
+
+			if len(trivia.Questions) != DefaultRecipe.QuestionCount {
+				t.Errorf("expected %d preview questions; got %d", DefaultRecipe.QuestionCount, len(trivia.Questions))
+			}
+
+			if !store.rolledBack || store.committed {
+				t.Errorf("expected preview transaction to be rolled back")
+			}
+		})
+	}
+}
+
+// createTestTrivia stores a trivia for the date with the given questions, each
+// with a correct and an incorrect answer.
+func createTestTrivia(t *testing.T, store storage.IStore, date string, questions []types.TriviaQuestion) *types.TriviaDto {
+	ctx := context.Background()
+	triviaDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := store.CreateTrivia(ctx, "test", triviaDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, question := range questions {
+		question.TriviaId = id
+		questionID, err := store.CreateTriviaQuestion(ctx, question)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, correct := range []bool{true, false} {
+			if err := store.CreateTriviaAnswer(ctx, types.TriviaAnswer{TriviaQuestionID: questionID, Text: "test", IsCorrect: correct}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if err := store.SetTriviaMaxScore(ctx, id, len(questions)); err != nil {
+		t.Fatal(err)
+	}
+
+	trivia, err := store.GetTrivia(ctx, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trivia
+}
+
+func questionIDs(trivia *types.TriviaDto) map[int]bool {
+	ids := make(map[int]bool)
+	for _, question := range trivia.Questions {
+		ids[question.ID] = true
+	}
+	return ids
+}
+
+var testTriviaQuestions = []types.TriviaQuestion{
+	{TypeID: types.QUESTION_TYPE_MAP, Question: "Which country is highlighted above?", Map: "WorldCountries", Highlighted: "Chile", Generator: "what-country"},
+	{TypeID: types.QUESTION_TYPE_FLAG, Question: "Which country has this flag?", FlagCode: "testing", Generator: "what-flag"},
+	{TypeID: types.QUESTION_TYPE_TEXT, Question: "Which question has no source?"},
+}
+
+func TestRegenerateTriviaQuestion(t *testing.T) {
+	tt := []struct {
+		name     string
+		question int
+		expected error
+	}{
+		{
+			name:     "question not in trivia",
+			question: -1,
+			expected: ErrQuestionNotFound,
+		},
+		{
+			name:     "question without a source",
+			question: 2,
+			expected: ErrInvalidInput,
+		},
+		{
+			name:     "happy path",
+			question: 1,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := storage.NewSeededMemoryStore()
+			service := NewService(store)
+			trivia := createTestTrivia(t, store, "2022-01-01", testTriviaQuestions)
+
+			questionID := -1
+			if tc.question >= 0 {
+				questionID = trivia.Questions[tc.question].ID
+			}
+
+			err := service.RegenerateTriviaQuestion(ctx, "2022-01-01", questionID)
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected error %v; got %v", tc.expected, err)
+			}
+
+			result, err := store.GetTrivia(ctx, "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(result.Questions) != len(trivia.Questions) || result.MaxScore != len(trivia.Questions) {
+				t.Fatalf("expected %d questions and max score; got %d questions and max score %d", len(trivia.Questions), len(result.Questions), result.MaxScore)
+			}
+
+			if tc.expected != nil {
+				return
+			}
+
+			if questionIDs(result)[questionID] {
+				t.Errorf("expected question %d to be replaced", questionID)
+			}
+
+			replacement := result.Questions[len(result.Questions)-1]
+			question, err := store.GetTriviaQuestion(ctx, replacement.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if question.Generator != "what-flag" {
+				t.Errorf("expected replacement from what-flag; got %q", question.Generator)
+			}
+		})
+	}
+}
+
+func TestRegenerateTriviaWithPinnedQuestions(t *testing.T) {
+	tt := []struct {
+		name     string
+		pinned   []int
+		expected error
+	}{
+		{
+			name:     "pinned question not in trivia",
+			pinned:   []int{-1},
+			expected: ErrQuestionNotFound,
+		},
+		{
+			name:     "happy path",
+			pinned:   []int{1},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := storage.NewSeededMemoryStore()
+			service := NewService(store)
+			trivia := createTestTrivia(t, store, "2022-01-01", testTriviaQuestions)
+
+			var pinned []int
+			for _, index := range tc.pinned {
+				if index < 0 {
+					pinned = append(pinned, index)
+					continue
+				}
+				pinned = append(pinned, trivia.Questions[index].ID)
+			}
+
+			err := service.RegenerateTrivia(ctx, "2022-01-01", "", pinned)
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected error %v; got %v", tc.expected, err)
+			}
+
+			if tc.expected != nil {
+				return
+			}
+
+			result, err := store.GetTrivia(ctx, "2022-01-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(result.Questions) != DefaultRecipe.QuestionCount || result.MaxScore != DefaultRecipe.QuestionCount {
+				t.Errorf("expected %d questions and max score; got %d questions and max score %d", DefaultRecipe.QuestionCount, len(result.Questions), result.MaxScore)
+			}
+
+			ids := questionIDs(result)
+			for _, question := range trivia.Questions {
+				if ids[question.ID] != containsInt(pinned, question.ID) {
+					t.Errorf("expected only pinned questions %v to be kept; got %v", pinned, ids)
+				}
+			}
+		})
+	}
+}
+
+func containsInt(values []int, value int) bool {
+	for _, val := range values {
+		if val == value {
+			return true
+		}
 	}
+	return false
 }